@@ -0,0 +1,122 @@
+package durufmt
+
+import "time"
+
+// Between создаёт структуру *Durafmt для промежутка между from и to, который раскладывается
+// по настоящему календарю: с учётом длины месяцев, високосных лет и часового пояса from.
+// Например, 400 дней от 1 января 2023 года — это "1 год 1 месяц 4 дня".
+// Если to раньше from, продолжительность будет отрицательной.
+func Between(from, to time.Time) *Durafmt {
+	return ParseAt(from, to.Sub(from))
+}
+
+// ParseAt создаёт структуру *Durafmt для продолжительности dinput, отсчитываемой от момента anchor.
+// Годы, месяцы и дни считаются по календарю, начиная с anchor (для отрицательной продолжительности —
+// заканчивая anchor).
+func ParseAt(anchor time.Time, dinput time.Duration) *Durafmt {
+	d := Parse(dinput)
+	d.anchor = &anchor
+
+	return d
+}
+
+// calendar сообщает, раскладывается ли продолжительность по календарю. Если старшая единица времени
+// ограничена неделями или меньшими единицами либо считается рабочее время, календарь не нужен.
+func (d *Durafmt) calendar() bool {
+	if d.anchor == nil || d.anchor.IsZero() || d.workDay > 0 {
+		return false
+	}
+
+	return d.limitUnit == "" || calendarMonths[d.limitUnit] > 0
+}
+
+// calendarMonths хранит длину единиц времени, которые считаются по календарю, в месяцах.
+var calendarMonths = map[string]int{
+	Centuries: 1200,
+	Decades:   120,
+	Years:     12,
+	Quarters:  3,
+	Months:    1,
+}
+
+// calendarDurationValues раскладывает промежуток между start и end (start не позже end) по единицам времени.
+// Годы, месяцы и дни отсчитываются по календарю в часовом поясе start, оставшееся время — по
+// единицам фиксированной длины.
+func calendarDurationValues(start, end time.Time, limitUnit string) durationValues {
+	end = end.In(start.Location())
+
+	months := (end.Year()-start.Year())*12 + int(end.Month()) - int(start.Month())
+	if addMonths(start, months).After(end) {
+		months--
+	}
+
+	cursor := addMonths(start, months)
+
+	// Дни считаем по календарю, чтобы сутки с переходом на летнее время тоже были одним днём.
+	days := int(end.Sub(cursor) / (24 * time.Hour))
+	for days > 0 && cursor.AddDate(0, 0, days).After(end) {
+		days--
+	}
+
+	for !cursor.AddDate(0, 0, days+1).After(end) {
+		days++
+	}
+
+	cursor = cursor.AddDate(0, 0, days)
+
+	values := fixedDurationValues(end.Sub(cursor), Hours)
+	values[unitIndex(Weeks)] = int64(days / 7)
+	values[unitIndex(Days)] = int64(days % 7)
+
+	// Раскладываем месяцы по годам и другим единицам времени, которые начинаются с limitUnit. Века,
+	// десятилетия и кварталы используются, только если совпадают с limitUnit, месяцы — всегда.
+	limitIdx := unitIndex(limitUnit)
+	if limitUnit == "" {
+		limitIdx = unitIndex(Years)
+	}
+
+	for idx, unit := range units {
+		size := calendarMonths[unit]
+		if size == 0 || idx < limitIdx || optionalUnits[idx] && unit != limitUnit && unit != Months {
+			continue
+		}
+
+		values[idx] = int64(months / size)
+		months %= size
+	}
+
+	return values
+}
+
+// addMonths прибавляет к t n календарных месяцев. В отличие от time.AddDate, день месяца не
+// переносится на следующий месяц: 31 января + 1 месяц = 28 (29) февраля.
+func addMonths(t time.Time, n int) time.Time {
+	year, month, day := t.Date()
+	hour, minute, sec := t.Clock()
+
+	// Нулевой день следующего месяца — последний день нужного месяца.
+	lastDay := time.Date(year, month+time.Month(n)+1, 0, 0, 0, 0, 0, t.Location()).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return time.Date(year, month+time.Month(n), day, hour, minute, sec, t.Nanosecond(), t.Location())
+}
+
+// calendarAdd прибавляет к start продолжительность, разложенную по единицам времени: века, годы, месяцы и т.д. —
+// по календарю, недели и дни — календарными днями, остальное — по единицам фиксированной длины.
+func calendarAdd(start time.Time, values *durationValues) time.Time {
+	months := 0
+	for idx, unit := range units {
+		months += int(values[idx]) * calendarMonths[unit]
+	}
+
+	t := addMonths(start, months)
+	t = t.AddDate(0, 0, int(values[unitIndex(Weeks)]*7+values[unitIndex(Days)]))
+
+	for idx := unitIndex(Hours); idx < len(units); idx++ {
+		t = t.Add(time.Duration(values[idx]) * unitDurations[idx])
+	}
+
+	return t
+}
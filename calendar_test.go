@@ -0,0 +1,98 @@
+package durufmt
+
+import (
+	"testing"
+	"time"
+)
+
+// TestBetween тестирует раскладку промежутка между двумя моментами по календарю.
+func TestBetween(t *testing.T) {
+	date := func(year int, month time.Month, day, hour int) time.Time {
+		return time.Date(year, month, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	testTimes := []struct {
+		from      time.Time
+		to        time.Time
+		limitUnit string
+		expected  string
+	}{
+		{date(2023, 1, 1, 0), date(2023, 1, 1, 0).Add(400 * 24 * time.Hour), "", "1 год 1 месяц 4 дня"},
+		{date(2023, 1, 31, 0), date(2023, 3, 1, 0), "", "1 месяц 1 день"},
+		{date(2024, 1, 31, 0), date(2024, 2, 29, 0), "", "1 месяц"},
+		{date(2024, 2, 29, 0), date(2025, 2, 28, 0), "", "1 год"},
+		{date(2024, 2, 29, 0), date(2028, 2, 29, 0), "", "4 года"},
+		{date(2023, 2, 1, 0), date(2023, 3, 1, 0), "", "1 месяц"},
+		{date(2024, 2, 1, 0), date(2024, 3, 1, 0), "", "1 месяц"},
+		{date(2023, 1, 1, 0), date(2023, 1, 20, 5), "", "2 недели 5 дней 5 часов"},
+		{date(2023, 1, 1, 10), date(2023, 2, 1, 9), "", "4 недели 2 дня 23 часа"},
+		{date(2023, 1, 1, 0), date(2024, 3, 2, 0), Months, "14 месяцев 1 день"},
+		{date(2023, 1, 1, 0), date(2023, 1, 1, 0).Add(400 * 24 * time.Hour), Days, "400 дней"},
+		{date(2023, 3, 1, 0), date(2023, 1, 31, 0), "", "-1 месяц 1 день"},
+		{date(2023, 1, 1, 0), date(2023, 1, 1, 0), "", "0 секунд"},
+		{date(2000, 1, 1, 0), date(2023, 7, 15, 0), Decades, "2 десятилетия 3 года 6 месяцев 2 недели"},
+		{date(2000, 1, 1, 0), date(2023, 7, 15, 0), Quarters, "94 квартала 2 недели"},
+		{date(2023, 1, 1, 0), date(2023, 8, 1, 0), Quarters, "2 квартала 1 месяц"},
+		{date(1900, 1, 1, 0), date(2023, 1, 1, 0), Centuries, "1 век 23 года"},
+		{date(1900, 1, 1, 0), date(2023, 1, 1, 0), "", "123 года"},
+	}
+
+	for _, table := range testTimes {
+		result := Between(table.from, table.to).LimitToUnit(table.limitUnit).String()
+		if result != table.expected {
+			t.Errorf("Between(%v, %v).LimitToUnit(%q).String() = %q, ожидалось %q",
+				table.from, table.to, table.limitUnit, result, table.expected)
+		}
+	}
+}
+
+// TestBetweenTimeZones тестирует раскладку по календарю с переходом на летнее время.
+func TestBetweenTimeZones(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("нет базы часовых поясов:", err)
+	}
+
+	// 26 марта 2023 года в Берлине длится 23 часа, но это всё равно один день.
+	from := time.Date(2023, 3, 25, 12, 0, 0, 0, berlin)
+	to := time.Date(2023, 3, 26, 12, 0, 0, 0, berlin)
+
+	if result := Between(from, to).String(); result != "1 день" {
+		t.Errorf("Between(%v, %v).String() = %q, ожидалось %q", from, to, result, "1 день")
+	}
+
+	if result := Parse(to.Sub(from)).String(); result != "23 часа" {
+		t.Errorf("Parse(%v).String() = %q, ожидалось %q", to.Sub(from), result, "23 часа")
+	}
+
+	// Момент to в другом часовом поясе приводится к часовому поясу from.
+	if result := Between(from, to.UTC()).String(); result != "1 день" {
+		t.Errorf("Between(%v, %v).String() = %q, ожидалось %q", from, to.UTC(), result, "1 день")
+	}
+}
+
+// TestParseAt тестирует раскладку продолжительности, отсчитываемой от заданного момента.
+func TestParseAt(t *testing.T) {
+	anchor := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	testTimes := []struct {
+		test     time.Duration
+		expected string
+	}{
+		{30 * 24 * time.Hour, "1 месяц"},
+		{-29 * 24 * time.Hour, "-4 недели 1 день"},
+		{-31 * 24 * time.Hour, "-1 месяц 2 дня"},
+		{366 * 24 * time.Hour, "1 год 1 день"},
+	}
+
+	for _, table := range testTimes {
+		result := ParseAt(anchor, table.test).String()
+		if result != table.expected {
+			t.Errorf("ParseAt(%v, %v).String() = %q, ожидалось %q", anchor, table.test, result, table.expected)
+		}
+	}
+
+	if result := Parse(400 * 24 * time.Hour).LimitToUnit(Months).String(); result != "13 месяцев 1 неделя 3 дня" {
+		t.Errorf("Parse(400d).LimitToUnit(Months).String() = %q", result)
+	}
+}
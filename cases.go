@@ -0,0 +1,102 @@
+package durufmt
+
+const (
+	// Константы грамматических падежей, в которых могут выводиться единицы времени.
+	Nominative    = "nominative"    // Именительный: "2 минуты".
+	Genitive      = "genitive"      // Родительный: "в течение 2 минут".
+	Dative        = "dative"        // Дательный: "к 2 минутам".
+	Accusative    = "accusative"    // Винительный: "через 2 минуты", "1 минуту назад".
+	Instrumental  = "instrumental"  // Творительный: "с 2 минутами".
+	Prepositional = "prepositional" // Предложный: "о 2 минутах".
+)
+
+// unitCases хранит склонения единиц времени для всех падежей, кроме именительного (он хранится в unitNames).
+// В косвенных падежах числительное согласуется с существительным, поэтому для Some и Many
+// используется форма множественного числа того же падежа.
+var unitCases = map[string]map[string]map[string]string{
+	Genitive: {
+		Centuries:    {Singular: "века", Some: "веков", Many: "веков"},
+		Decades:      {Singular: "десятилетия", Some: "десятилетий", Many: "десятилетий"},
+		Years:        {Singular: "года", Some: "лет", Many: "лет"},
+		Quarters:     {Singular: "квартала", Some: "кварталов", Many: "кварталов"},
+		Months:       {Singular: "месяца", Some: "месяцев", Many: "месяцев"},
+		Weeks:        {Singular: "недели", Some: "недель", Many: "недель"},
+		Days:         {Singular: "дня", Some: "дней", Many: "дней"},
+		Hours:        {Singular: "часа", Some: "часов", Many: "часов"},
+		Minutes:      {Singular: "минуты", Some: "минут", Many: "минут"},
+		Seconds:      {Singular: "секунды", Some: "секунд", Many: "секунд"},
+		Milliseconds: {Singular: "миллисекунды", Some: "миллисекунд", Many: "миллисекунд"},
+		Microseconds: {Singular: "микросекунды", Some: "микросекунд", Many: "микросекунд"},
+		Nanoseconds:  {Singular: "наносекунды", Some: "наносекунд", Many: "наносекунд"},
+	},
+	Dative: {
+		Centuries:    {Singular: "веку", Some: "векам", Many: "векам"},
+		Decades:      {Singular: "десятилетию", Some: "десятилетиям", Many: "десятилетиям"},
+		Years:        {Singular: "году", Some: "годам", Many: "годам"},
+		Quarters:     {Singular: "кварталу", Some: "кварталам", Many: "кварталам"},
+		Months:       {Singular: "месяцу", Some: "месяцам", Many: "месяцам"},
+		Weeks:        {Singular: "неделе", Some: "неделям", Many: "неделям"},
+		Days:         {Singular: "дню", Some: "дням", Many: "дням"},
+		Hours:        {Singular: "часу", Some: "часам", Many: "часам"},
+		Minutes:      {Singular: "минуте", Some: "минутам", Many: "минутам"},
+		Seconds:      {Singular: "секунде", Some: "секундам", Many: "секундам"},
+		Milliseconds: {Singular: "миллисекунде", Some: "миллисекундам", Many: "миллисекундам"},
+		Microseconds: {Singular: "микросекунде", Some: "микросекундам", Many: "микросекундам"},
+		Nanoseconds:  {Singular: "наносекунде", Some: "наносекундам", Many: "наносекундам"},
+	},
+	Accusative: {
+		Centuries:    {Singular: "век", Some: "века", Many: "веков"},
+		Decades:      {Singular: "десятилетие", Some: "десятилетия", Many: "десятилетий"},
+		Years:        {Singular: "год", Some: "года", Many: "лет"},
+		Quarters:     {Singular: "квартал", Some: "квартала", Many: "кварталов"},
+		Months:       {Singular: "месяц", Some: "месяца", Many: "месяцев"},
+		Weeks:        {Singular: "неделю", Some: "недели", Many: "недель"},
+		Days:         {Singular: "день", Some: "дня", Many: "дней"},
+		Hours:        {Singular: "час", Some: "часа", Many: "часов"},
+		Minutes:      {Singular: "минуту", Some: "минуты", Many: "минут"},
+		Seconds:      {Singular: "секунду", Some: "секунды", Many: "секунд"},
+		Milliseconds: {Singular: "миллисекунду", Some: "миллисекунды", Many: "миллисекунд"},
+		Microseconds: {Singular: "микросекунду", Some: "микросекунды", Many: "микросекунд"},
+		Nanoseconds:  {Singular: "наносекунду", Some: "наносекунды", Many: "наносекунд"},
+	},
+	Instrumental: {
+		Centuries:    {Singular: "веком", Some: "веками", Many: "веками"},
+		Decades:      {Singular: "десятилетием", Some: "десятилетиями", Many: "десятилетиями"},
+		Years:        {Singular: "годом", Some: "годами", Many: "годами"},
+		Quarters:     {Singular: "кварталом", Some: "кварталами", Many: "кварталами"},
+		Months:       {Singular: "месяцем", Some: "месяцами", Many: "месяцами"},
+		Weeks:        {Singular: "неделей", Some: "неделями", Many: "неделями"},
+		Days:         {Singular: "днём", Some: "днями", Many: "днями"},
+		Hours:        {Singular: "часом", Some: "часами", Many: "часами"},
+		Minutes:      {Singular: "минутой", Some: "минутами", Many: "минутами"},
+		Seconds:      {Singular: "секундой", Some: "секундами", Many: "секундами"},
+		Milliseconds: {Singular: "миллисекундой", Some: "миллисекундами", Many: "миллисекундами"},
+		Microseconds: {Singular: "микросекундой", Some: "микросекундами", Many: "микросекундами"},
+		Nanoseconds:  {Singular: "наносекундой", Some: "наносекундами", Many: "наносекундами"},
+	},
+	Prepositional: {
+		Centuries:    {Singular: "веке", Some: "веках", Many: "веках"},
+		Decades:      {Singular: "десятилетии", Some: "десятилетиях", Many: "десятилетиях"},
+		Years:        {Singular: "годе", Some: "годах", Many: "годах"},
+		Quarters:     {Singular: "квартале", Some: "кварталах", Many: "кварталах"},
+		Months:       {Singular: "месяце", Some: "месяцах", Many: "месяцах"},
+		Weeks:        {Singular: "неделе", Some: "неделях", Many: "неделях"},
+		Days:         {Singular: "дне", Some: "днях", Many: "днях"},
+		Hours:        {Singular: "часе", Some: "часах", Many: "часах"},
+		Minutes:      {Singular: "минуте", Some: "минутах", Many: "минутах"},
+		Seconds:      {Singular: "секунде", Some: "секундах", Many: "секундах"},
+		Milliseconds: {Singular: "миллисекунде", Some: "миллисекундах", Many: "миллисекундах"},
+		Microseconds: {Singular: "микросекунде", Some: "микросекундах", Many: "микросекундах"},
+		Nanoseconds:  {Singular: "наносекунде", Some: "наносекундах", Many: "наносекундах"},
+	},
+}
+
+// unitForms возвращает формы названия единицы времени unit в падеже grammaticalCase.
+// Для именительного и неизвестных падежей возвращаются формы из unitNames.
+func unitForms(grammaticalCase, unit string) map[string]string {
+	if forms, ok := unitCases[grammaticalCase]; ok {
+		return forms[unit]
+	}
+
+	return unitNames[unit]
+}
@@ -0,0 +1,60 @@
+package durufmt
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParseInCase тестирует вывод единиц времени в разных падежах.
+func TestParseInCase(t *testing.T) {
+	testTimesInCase := []struct {
+		test     time.Duration
+		gramCase string
+		expected string
+	}{
+		{1 * time.Minute, "", "1 минута"},
+		{1 * time.Minute, Nominative, "1 минута"},
+		{1 * time.Minute, Accusative, "1 минуту"},
+		{2 * time.Minute, Accusative, "2 минуты"},
+		{5 * time.Minute, Accusative, "5 минут"},
+		{21 * time.Minute, Accusative, "21 минуту"},
+		{(2 * 168 * time.Hour) + time.Minute, Accusative, "2 недели 1 минуту"},
+		{1 * time.Hour, Accusative, "1 час"},
+		{168 * time.Hour, Accusative, "1 неделю"},
+		{1 * time.Minute, Genitive, "1 минуты"},
+		{2 * time.Minute, Genitive, "2 минут"},
+		{11 * time.Minute, Genitive, "11 минут"},
+		{8760 * time.Hour, Genitive, "1 года"},
+		{17520 * time.Hour, Genitive, "2 лет"},
+		{24 * time.Hour, Dative, "1 дню"},
+		{48 * time.Hour, Dative, "2 дням"},
+		{1 * time.Second, Instrumental, "1 секундой"},
+		{3 * time.Hour, Instrumental, "3 часами"},
+		{24 * time.Hour, Instrumental, "1 днём"},
+		{1 * time.Millisecond, Prepositional, "1 миллисекунде"},
+		{5 * time.Microsecond, Prepositional, "5 микросекундах"},
+		{-1 * time.Minute, Accusative, "-1 минуту"},
+	}
+
+	for _, table := range testTimesInCase {
+		result := Parse(table.test).InCase(table.gramCase).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).InCase(%q).String() = %q. получено %q, ожидалось %q",
+				table.test, table.gramCase, result, result, table.expected)
+		}
+	}
+}
+
+// TestUnitCasesComplete проверяет, что для каждого падежа заданы все формы всех единиц времени.
+func TestUnitCasesComplete(t *testing.T) {
+	for _, gramCase := range []string{Nominative, Genitive, Dative, Accusative, Instrumental, Prepositional} {
+		for _, unit := range units {
+			forms := unitForms(gramCase, unit)
+			for _, form := range []string{Singular, Some, Many} {
+				if forms[form] == "" {
+					t.Errorf("не задана форма %q единицы %q в падеже %q", form, unit, gramCase)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package durufmt
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// Стили сравнения продолжительностей.
+	CompareDifference = "difference" // "на 5 минут быстрее", "на 2 часа дольше" (по умолчанию).
+	CompareRatio      = "ratio"      // "в 3 раза быстрее", "в 1,5 раза медленнее".
+)
+
+// timesNames хранит формы слова "раз" после числа: "в 1 раз", "в 3 раза", "в 5 раз", "в 1,5 раза".
+var timesNames = map[string]string{Singular: "раз", Some: "раза", Many: "раз", Other: "раза"}
+
+// Compare сравнивает продолжительности с настройками по умолчанию: "на 5 минут быстрее",
+// см. Formatter.Compare().
+func Compare(actual, expected time.Duration) string {
+	return defaultFormatter.Compare(actual, expected)
+}
+
+// Compare сравнивает продолжительность actual с expected (например, текущий запуск с предыдущим):
+// "на 5 минут быстрее", если actual короче, "на 2 часа дольше", если длиннее, и "столько же", если они равны.
+// Разница выводится в винительном падеже с настройками f: "на 1 минуту дольше". Со стилем CompareRatio
+// выводится отношение с точностью до десятых: "в 3 раза медленнее", "в 1,5 раза быстрее"; если одна из
+// продолжительностей нулевая, отношение не определено и выводится разница. Разница, которая не помещается
+// в time.Duration, выводится как math.MaxInt64. Compare() всегда выводит
+// результат на русском языке (названия, заменённые через CustomLocale() на основе Russian, сохраняются),
+// Predicate() и Period() не учитываются.
+func (f *Formatter) Compare(actual, expected time.Duration) string {
+	d := (&Durafmt{options: &f.options}).with(func(o *options) {
+		o.gramCase = Accusative
+		if !isRussian(o.locale) {
+			o.locale = Russian
+		}
+		o.predicate = ""
+		o.adjective = ""
+	})
+
+	if actual == expected {
+		return "столько же"
+	}
+
+	faster := actual < expected
+
+	if d.comparison == CompareRatio && actual > 0 && expected > 0 {
+		ratio := float64(actual) / float64(expected)
+		if faster {
+			ratio = 1 / ratio
+		}
+
+		ratio = math.Round(ratio*10) / 10
+		if ratio == 1 {
+			return "столько же"
+		}
+
+		b := append(make([]byte, 0, 64), "в "...)
+		b = d.appendTimes(b, ratio)
+
+		if faster {
+			return string(append(b, " быстрее"...))
+		}
+
+		return string(append(b, " медленнее"...))
+	}
+
+	// Направление передаёт слово "быстрее" или "дольше", поэтому разница выводится без знака.
+	d.duration = difference(actual, expected)
+
+	b := append(make([]byte, 0, 64), "на "...)
+	b = d.appendTo(b)
+
+	if faster {
+		return string(append(b, " быстрее"...))
+	}
+
+	return string(append(b, " дольше"...))
+}
+
+// difference возвращает модуль разности a и b. Если он не помещается в time.Duration (например, у
+// math.MaxInt64 и -time.Hour), возвращается math.MaxInt64.
+func difference(a, b time.Duration) time.Duration {
+	if a < b {
+		a, b = b, a
+	}
+
+	// При переполнении разность двух чисел со знаком становится отрицательной.
+	if diff := a - b; diff >= 0 {
+		return diff
+	}
+
+	return math.MaxInt64
+}
+
+// appendTimes дописывает в b отношение ratio со словом "раз" в нужной форме: "3 раза", "1,5 раза", "три раза".
+func (d *Durafmt) appendTimes(b []byte, ratio float64) []byte {
+	if ratio != math.Trunc(ratio) {
+		b = append(b, strings.Replace(strconv.FormatFloat(ratio, 'f', -1, 64), ".", ",", 1)...)
+		b = append(b, ' ')
+
+		return append(b, timesNames[Other]...)
+	}
+
+	n := int64(ratio)
+
+	if d.spelled() {
+		b = append(b, spellNumber(n, Masculine, Accusative)...)
+	} else {
+		b = strconv.AppendInt(b, n, 10)
+	}
+
+	b = append(b, ' ')
+
+	return append(b, timesNames[pluralCategory(n)]...)
+}
@@ -0,0 +1,64 @@
+package durufmt
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestCompare тестирует сравнение продолжительностей.
+func TestCompare(t *testing.T) {
+	ratio := WithComparison(CompareRatio)
+
+	testCompares := []struct {
+		formatter        *Formatter
+		actual, expected time.Duration
+		result           string
+	}{
+		{NewFormatter(), 10 * time.Minute, 15 * time.Minute, "на 5 минут быстрее"},
+		{NewFormatter(), 5 * time.Hour, 3 * time.Hour, "на 2 часа дольше"},
+		{NewFormatter(), 2 * time.Minute, time.Minute, "на 1 минуту дольше"},
+		{NewFormatter(), 22 * time.Minute, time.Minute, "на 21 минуту дольше"},
+		{NewFormatter(), time.Hour, 90 * time.Minute, "на 30 минут быстрее"},
+		{NewFormatter(), 2*time.Hour + time.Minute, time.Minute, "на 2 часа дольше"},
+		{NewFormatter(), 2*time.Hour + 2*time.Minute, time.Minute, "на 2 часа 1 минуту дольше"},
+		{NewFormatter(), time.Minute, time.Minute, "столько же"},
+		{NewFormatter(), 0, time.Second, "на 1 секунду быстрее"},
+		{NewFormatter(WithWords(true)), 2 * time.Minute, time.Minute, "на одну минуту дольше"},
+		{NewFormatter(WithWords(true)), time.Minute, 2 * time.Minute, "на одну минуту быстрее"},
+		{NewFormatter(WithDecimal(Hours, 1)), 3 * time.Hour, 90 * time.Minute, "на 1,5 часа дольше"},
+		{NewFormatter(WithLimitFirstN(1)), 3*time.Hour + 5*time.Minute, time.Hour, "на 2 часа дольше"},
+		{NewFormatter(WithLocale(English), WithPredicate("осталось")), 2 * time.Minute, time.Minute, "на 1 минуту дольше"},
+		{NewFormatter(ratio), 3 * time.Minute, time.Minute, "в 3 раза медленнее"},
+		{NewFormatter(ratio), time.Minute, 3 * time.Minute, "в 3 раза быстрее"},
+		{NewFormatter(ratio), 10 * time.Minute, time.Minute, "в 10 раз медленнее"},
+		{NewFormatter(ratio), 21 * time.Minute, time.Minute, "в 21 раз медленнее"},
+		{NewFormatter(ratio), 90 * time.Second, time.Minute, "в 1,5 раза медленнее"},
+		{NewFormatter(ratio), 61 * time.Second, time.Minute, "столько же"},
+		{NewFormatter(ratio, WithWords(true)), 2 * time.Minute, time.Minute, "в два раза медленнее"},
+		{NewFormatter(ratio), time.Minute, 0, "на 1 минуту дольше"},
+		{NewFormatter(ratio), time.Minute, time.Minute, "столько же"},
+		{NewFormatter(), -time.Hour, time.Hour, "на 2 часа быстрее"},
+		{NewFormatter(), -2 * time.Hour, -3 * time.Hour, "на 1 час дольше"},
+		{NewFormatter(WithLimitFirstN(2)), math.MaxInt64, -time.Hour, "на 292 года 24 недели дольше"},
+		{NewFormatter(WithLimitFirstN(2)), math.MinInt64, math.MaxInt64, "на 292 года 24 недели быстрее"},
+		{NewFormatter(WithLimitFirstN(2)), math.MinInt64, 0, "на 292 года 24 недели быстрее"},
+		{NewFormatter(ratio), -time.Minute, time.Minute, "на 2 минуты быстрее"},
+	}
+
+	for _, table := range testCompares {
+		if result := table.formatter.Compare(table.actual, table.expected); result != table.result {
+			t.Errorf("Compare(%v, %v) = %q, ожидалось %q", table.actual, table.expected, result, table.result)
+		}
+	}
+
+	if result := Compare(10*time.Minute, 15*time.Minute); result != "на 5 минут быстрее" {
+		t.Errorf("Compare() = %q, ожидалось %q", result, "на 5 минут быстрее")
+	}
+
+	expected := "на 292 года 24 недели 3 дня 23 часа 47 минут 16 секунд 854 миллисекунды 775 микросекунд " +
+		"807 наносекунд дольше"
+	if result := Compare(math.MaxInt64, -time.Hour); result != expected {
+		t.Errorf("Compare(math.MaxInt64, -1h) = %q, ожидалось %q", result, expected)
+	}
+}
@@ -0,0 +1,88 @@
+package durufmt
+
+import (
+	"bytes"
+	"strconv"
+	"time"
+)
+
+// Decimal включает вывод продолжительности одним дробным числом в единице времени unit с precision
+// знаками после запятой: "1,5 часа", "2,25 дня". Нули в конце дробной части отбрасываются.
+// unit = "" или неизвестная единица времени означает наибольшую единицу времени, в которой продолжительность
+// не меньше единицы.
+// Дробные значения всегда считаются по фиксированной длине единиц (год — 365 дней, месяц — 30 дней).
+func (d *Durafmt) Decimal(unit string, precision int) *Durafmt {
+	return d.with(WithDecimal(unit, precision))
+}
+
+// appendDecimal дописывает в b модуль продолжительности одним дробным числом.
+func (d *Durafmt) appendDecimal(b []byte, duration time.Duration) []byte {
+	unit := d.fractionUnit(duration)
+
+	precision := d.precision
+	if precision < 0 {
+		precision = 0
+	}
+
+	start := len(b)
+	b = strconv.AppendFloat(b, float64(duration)/float64(d.unitSize(unit)), 'f', precision, 64)
+
+	value := b[start:]
+	if bytes.IndexByte(value, '.') >= 0 {
+		value = bytes.TrimRight(bytes.TrimRight(value, "0"), ".")
+		b = b[:start+len(value)]
+	}
+
+	// Целое значение форматируется по обычным правилам: "2 часа".
+	dot := bytes.IndexByte(value, '.')
+	if dot < 0 {
+		if v, err := strconv.ParseInt(string(value), 10, 64); err == nil {
+			return d.appendUnit(b[:start], unit, v)
+		}
+	}
+
+	// Заменяем точку десятичным разделителем языка.
+	lang := d.lang()
+	fraction := string(b[start+dot+1:])
+	b = append(append(b[:start+dot], lang.DecimalSeparator()...), fraction...)
+
+	if abbr := d.unitAbbr(unit); abbr != "" {
+		if d.style != StyleNarrow {
+			b = append(b, ' ')
+		}
+
+		return append(b, abbr...)
+	}
+
+	// В русском языке после дробного числа существительное стоит в родительном падеже единственного
+	// числа: "1,5 часа", "0,5 минуты", "о 2,7 секунды".
+	b = append(b, ' ')
+
+	return d.appendUnitName(b, d.gramCase, unit, lang.FractionCategory())
+}
+
+// fractionUnit возвращает единицу времени дробного числа для модуля продолжительности duration: заданную
+// в Decimal(), а если она не задана или неизвестна — выбранную decimalUnit().
+func (d *Durafmt) fractionUnit(duration time.Duration) string {
+	if unitIndex(d.decimalUnit) < 0 {
+		return decimalUnit(duration, d.workDay)
+	}
+
+	return d.decimalUnit
+}
+
+// decimalUnit возвращает наибольшую единицу времени (кроме единиц из optionalUnits), в которой продолжительность
+// не меньше единицы. workDay > 0 означает рабочее время: старшая единица — рабочий день длиной workDay.
+func decimalUnit(duration, workDay time.Duration) string {
+	for idx, unit := range units {
+		if workDay > 0 && idx < unitIndex(Days) {
+			continue
+		}
+
+		if !optionalUnits[idx] && duration >= unitSize(unit, workDay) {
+			return unit
+		}
+	}
+
+	return Seconds
+}
@@ -0,0 +1,59 @@
+package durufmt
+
+import (
+	"testing"
+	"time"
+)
+
+// TestDecimal тестирует вывод продолжительности одним дробным числом.
+func TestDecimal(t *testing.T) {
+	testTimes := []struct {
+		test      time.Duration
+		unit      string
+		precision int
+		gramCase  string
+		expected  string
+	}{
+		{90 * time.Minute, Hours, 1, "", "1,5 часа"},
+		{90 * time.Minute, "", 1, "", "1,5 часа"},
+		{54 * time.Hour, Days, 2, "", "2,25 дня"},
+		{30 * time.Second, Minutes, 1, "", "0,5 минуты"},
+		{2700 * time.Millisecond, Seconds, 1, "", "2,7 секунды"},
+		{2700 * time.Millisecond, Seconds, 0, "", "3 секунды"},
+		{2 * time.Hour, Hours, 2, "", "2 часа"},
+		{5 * time.Hour, Hours, 2, "", "5 часов"},
+		{21 * time.Minute, Minutes, 1, "", "21 минута"},
+		{100 * time.Minute, Hours, 2, "", "1,67 часа"},
+		{90 * time.Minute, Hours, 1, Accusative, "1,5 часа"},
+		{90 * time.Minute, Hours, 1, Instrumental, "1,5 часа"},
+		{60 * time.Minute, Hours, 1, Accusative, "1 час"},
+		{1 * time.Minute, Minutes, 1, Accusative, "1 минуту"},
+		{-90 * time.Minute, Hours, 1, "", "-1,5 часа"},
+		{36 * time.Hour, Weeks, 3, "", "0,214 недели"},
+		{(365 + 182) * 24 * time.Hour, "", 1, "", "1,5 года"},
+		{1500 * time.Nanosecond, "", 1, "", "1,5 микросекунды"},
+		{45 * 24 * time.Hour, Months, 1, "", "1,5 месяца"},
+		{90 * time.Minute, "fortnights", 1, "", "1,5 часа"},
+	}
+
+	for _, table := range testTimes {
+		result := Parse(table.test).Decimal(table.unit, table.precision).InCase(table.gramCase).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).Decimal(%q, %d).InCase(%q).String() = %q, ожидалось %q",
+				table.test, table.unit, table.precision, table.gramCase, result, table.expected)
+		}
+	}
+
+	if result := Parse(90*time.Minute).Decimal(Hours, 1).InStyle(StyleShort).String(); result != "1,5 ч" {
+		t.Errorf("Parse(90m).Decimal(Hours, 1).InStyle(StyleShort).String() = %q, ожидалось %q", result, "1,5 ч")
+	}
+
+	if result := Parse(time.Hour).Decimal("fortnights", 1).Predicate("осталось").String(); result != "остался 1 час" {
+		t.Errorf("Parse(1h).Decimal(\"fortnights\", 1).Predicate(\"осталось\").String() = %q, ожидалось %q",
+			result, "остался 1 час")
+	}
+
+	if result := Parse(0).Decimal("", 1).String(); result != "0 секунд" {
+		t.Errorf("Parse(0).Decimal(\"\", 1).String() = %q, ожидалось %q", result, "0 секунд")
+	}
+}
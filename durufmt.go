@@ -5,9 +5,7 @@
 package durufmt
 
 import (
-	"errors"
-	"fmt"
-	"regexp"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -15,6 +13,7 @@ import (
 
 const (
 	// Константы каноничных имён единиц времени.
+	Nanoseconds  = "nanoseconds"
 	Microseconds = "microseconds"
 	Milliseconds = "milliseconds"
 	Seconds      = "seconds"
@@ -22,23 +21,115 @@ const (
 	Hours        = "hours"
 	Days         = "days"
 	Weeks        = "weeks"
+	Months       = "months"
+	Quarters     = "quarters"
 	Years        = "years"
+	Decades      = "decades"
+	Centuries    = "centuries"
 
 	// Типы единственного и множественного чисел для выражения числительных на русском языке.
 	Singular = "one"  // 1, 21, 31... (но не 11)
 	Some     = "some" // 2, 3, 4, 22, 23, 24... (но не 12, 13 и 14)
 	Many     = "many" // 5, 15, 25, 35... (а так же 11, 12, 13 и 14)
+
+	// Грамматические рода единиц времени, с которыми согласуются числительные.
+	Masculine = "masculine" // один час, два дня
+	Feminine  = "feminine"  // одна минута, две недели
+	Neuter    = "neuter"    // одно, два
+	Plural    = "plural"    // Только множественное число: одни сутки, двое суток
+
+	// Стили вывода названий единиц времени.
+	StyleFull   = "full"   // Полные названия: "2 часа 15 минут 3 секунды".
+	StyleShort  = "short"  // Сокращения по ГОСТ: "2 ч 15 мин 3 с".
+	StyleNarrow = "narrow" // Сокращения без пробела: "2ч 15м 3с".
 )
 
 var (
-	units      = []string{Years, Weeks, Days, Hours, Minutes, Seconds, Milliseconds, Microseconds}
-	unitsShort = []string{"y", "w", "d", "h", "m", "s", "ms", "µs"}
-	unitNames  = map[string]map[string]string{
+	units = [...]string{
+		Centuries, Decades, Years, Quarters, Months, Weeks, Days,
+		Hours, Minutes, Seconds, Milliseconds, Microseconds, Nanoseconds,
+	}
+	// unitsShort хранит обозначения единиц времени в формате time.Duration. У веков, десятилетий
+	// и кварталов таких обозначений нет.
+	unitsShort = [...]string{"", "", "y", "", "mo", "w", "d", "h", "m", "s", "ms", "µs", "ns"}
+	// optionalUnits отмечает в порядке units единицы времени, которые выводятся, только если они явно
+	// заданы старшей единицей времени через LimitToUnit(): века, десятилетия, кварталы и месяцы.
+	optionalUnits = [...]bool{true, true, false, true, true, false, false, false, false, false, false, false, false}
+	unitsAbbr     = map[string]map[string]string{
+		StyleShort: {
+			Centuries:    "век",
+			Decades:      "дес",
+			Years:        "г",
+			Quarters:     "кв",
+			Months:       "мес",
+			Weeks:        "нед",
+			Days:         "д",
+			Hours:        "ч",
+			Minutes:      "мин",
+			Seconds:      "с",
+			Milliseconds: "мс",
+			Microseconds: "мкс",
+			Nanoseconds:  "нс",
+		},
+		StyleNarrow: {
+			Centuries:    "век",
+			Decades:      "дес",
+			Years:        "г",
+			Quarters:     "кв",
+			Months:       "мес",
+			Weeks:        "н",
+			Days:         "д",
+			Hours:        "ч",
+			Minutes:      "м",
+			Seconds:      "с",
+			Milliseconds: "мс",
+			Microseconds: "мкс",
+			Nanoseconds:  "нс",
+		},
+	}
+	// unitDurations хранит длительность каждой единицы времени в порядке units. Год и месяц без привязки
+	// к календарю считаются равными 365 и 30 дням, квартал — трём таким месяцам.
+	unitDurations = [...]time.Duration{
+		100 * 365 * 24 * time.Hour, // Centuries.
+		10 * 365 * 24 * time.Hour,  // Decades.
+		365 * 24 * time.Hour,       // Years.
+		90 * 24 * time.Hour,        // Quarters.
+		30 * 24 * time.Hour,        // Months.
+		7 * 24 * time.Hour,         // Weeks.
+		24 * time.Hour,             // Days.
+		time.Hour,                  // Hours.
+		time.Minute,                // Minutes.
+		time.Second,                // Seconds.
+		time.Millisecond,           // Milliseconds.
+		time.Microsecond,           // Microseconds.
+		time.Nanosecond,            // Nanoseconds.
+	}
+	unitNames = map[string]map[string]string{
+		Centuries: {
+			Singular: "век",
+			Some:     "века",
+			Many:     "веков",
+		},
+		Decades: {
+			Singular: "десятилетие",
+			Some:     "десятилетия",
+			Many:     "десятилетий",
+		},
 		Years: {
 			Singular: "год",
 			Some:     "года",
 			Many:     "лет",
 		},
+		Quarters: {
+			Singular: "квартал",
+			Some:     "квартала",
+			Many:     "кварталов",
+		},
+		Months: {
+			Singular: "месяц",
+			Some:     "месяца",
+			Many:     "месяцев",
+		},
 		Weeks: {
 			Singular: "неделя",
 			Some:     "недели",
@@ -74,31 +165,103 @@ var (
 			Some:     "микросекунды",
 			Many:     "микросекунд",
 		},
+		Nanoseconds: {
+			Singular: "наносекунда",
+			Some:     "наносекунды",
+			Many:     "наносекунд",
+		},
+	}
+	unitGenders = map[string]string{
+		Centuries:    Masculine,
+		Decades:      Neuter,
+		Years:        Masculine,
+		Quarters:     Masculine,
+		Months:       Masculine,
+		Weeks:        Feminine,
+		Days:         Masculine,
+		Hours:        Masculine,
+		Minutes:      Feminine,
+		Seconds:      Feminine,
+		Milliseconds: Feminine,
+		Microseconds: Feminine,
+		Nanoseconds:  Feminine,
 	}
 )
 
 // Durafmt хранит в себе спарсированный интервал времени и оригинальный ввод пользователя.
+// Durafmt неизменяема: методы настройки возвращают изменённую копию, а форматирование не имеет побочных
+// эффектов, поэтому одну и ту же *Durafmt можно кэшировать и использовать из нескольких горутин.
 type Durafmt struct {
-	duration  time.Duration
-	input     string // Справочная информация.
-	limitN    int    // В случае ненулевого значения ограничивает количество выдаваемых элементов в результате.
-	limitUnit string // Непустое значение лимитирует максимальную единицу времени для выдачи.
+	duration time.Duration
+	input    string     // Справочная информация.
+	anchor   *time.Time // Момент, от которого продолжительность раскладывается по календарю. См. ParseAt().
+
+	// Настройки не копируются в каждое значение, а разделяются с Formatter и копиями Durafmt,
+	// поэтому их нельзя менять на месте, только через with().
+	*options
+}
+
+// with возвращает копию d с собственной копией настроек, изменённой set.
+func (d *Durafmt) with(set Option) *Durafmt {
+	c := *d
+
+	var o options
+	if d.options != nil {
+		o = *d.options
+	}
+
+	set(&o)
+	c.options = &o
+
+	return &c
 }
 
 // LimitToUnit устанавливает формат вывода, вы не получите в итоговой строке единицу времени больше заданной.
+// Века (Centuries), десятилетия (Decades), кварталы (Quarters) и месяцы (Months) выводятся, только если
+// заданы здесь: LimitToUnit(Centuries) даёт "2 века 15 лет", а не "215 лет".
 // unit = "" означает отсутствие ограничений.
 func (d *Durafmt) LimitToUnit(unit string) *Durafmt {
-	d.limitUnit = unit
-
-	return d
+	return d.with(WithLimitToUnit(unit))
 }
 
 // LimitFirstN устанавливает формат вывода, ограничивая в нём количество элементов до n.
 // n == 0 означает отсутствие лимита.
 func (d *Durafmt) LimitFirstN(n int) *Durafmt {
-	d.limitN = n
+	return d.with(WithLimitFirstN(n))
+}
+
+// InCase устанавливает грамматический падеж, в котором будут выведены единицы времени.
+// Например, в винительном падеже получится "2 недели 1 минуту" (для фразы "через 2 недели 1 минуту"),
+// а в родительном — "1 минуты" (для фразы "в течение 1 минуты").
+// grammaticalCase = "" означает именительный падеж.
+func (d *Durafmt) InCase(grammaticalCase string) *Durafmt {
+	return d.with(WithCase(grammaticalCase))
+}
+
+// InStyle устанавливает стиль вывода названий единиц времени: StyleFull, StyleShort или StyleNarrow.
+// Сокращения не склоняются, поэтому в стилях StyleShort и StyleNarrow падеж и вывод прописью не учитываются.
+func (d *Durafmt) InStyle(style string) *Durafmt {
+	return d.with(WithStyle(style))
+}
+
+// InWords включает (или выключает) вывод количеств прописью: "двадцать один год" вместо "21 год".
+// Числительные согласуются с родом единицы времени и с падежом, заданным InCase().
+func (d *Durafmt) InWords(words bool) *Durafmt {
+	return d.with(WithWords(words))
+}
+
+// Separator устанавливает разделитель между элементами продолжительности, например ", ".
+// sep = "" означает пробел.
+func (d *Durafmt) Separator(sep string) *Durafmt {
+	return d.with(WithSeparator(sep))
+}
 
-	return d
+// Conjunction устанавливает союз перед последним элементом продолжительности:
+// с Separator(", ") и Conjunction("и") получится "2 часа, 5 минут и 3 секунды".
+// Если serial == true, разделитель ставится и перед союзом: "2 часа, 5 минут, и 3 секунды".
+// conj = "" означает отсутствие союза.
+func (d *Durafmt) Conjunction(conj string, serial bool) *Durafmt {
+	return d.with(WithConjunction(conj, serial))
 }
 
 func (d *Durafmt) Duration() time.Duration {
@@ -107,195 +270,281 @@ func (d *Durafmt) Duration() time.Duration {
 
 // Parse создаёт новую структуру *Durafmt. Возвращает ошибку в случае неправильных входных параметров.
 func Parse(dinput time.Duration) *Durafmt {
-	input := dinput.String()
-
-	return &Durafmt{dinput, input, 0, ""}
+	return defaultFormatter.Parse(dinput)
 }
 
 // ParseShort создаёт новую структуру *Durafmt, краткой формы. Возвращает ошибку в случае неправильных
 // входных параметров. Синоним `Parse(dur).LimitFirstN(1)`.
 func ParseShort(dinput time.Duration) *Durafmt {
-	input := dinput.String()
-
-	return &Durafmt{dinput, input, 1, ""}
+	return shortFormatter.Parse(dinput)
 }
 
 // ParseString создаёт структуру *Durafmt из строки. Формат строки аналогичен используемому в durafmt.
 // Возвращает ошибку в случае неправильных входных данных.
 func ParseString(input string) (*Durafmt, error) {
-	if input == "0" || input == "-0" {
-		return nil, errors.New("durafmt_ru: не указана единица времени во входном параметре " + input)
-	}
-
-	duration, err := time.ParseDuration(input)
-	if err != nil {
-		return nil, err
-	}
-
-	return &Durafmt{duration, input, 0, ""}, nil
+	return defaultFormatter.ParseString(input)
 }
 
 // ParseStringShort создаёт структуру *Durafmt из строки, краткой формы. Формат строки аналогичен
 // используемому в durafmt. Возвращает ошибку в случае неправильных входных данных.
 // Синоним вызова `ParseString(durStr)` и следующего за ним `LimitFirstN(1)`.
 func ParseStringShort(input string) (*Durafmt, error) {
-	if input == "0" || input == "-0" {
-		return nil, errors.New("durafmt_ru: не указана единица времени во входном параметре " + input)
+	return shortFormatter.ParseString(input)
+}
+
+// String форматирует *Durafmt в человекочитаемый вид.
+func (d *Durafmt) String() string {
+	return string(d.appendTo(make([]byte, 0, 64)))
+}
+
+// appendTo дописывает отформатированную продолжительность в b. Для обычного случая (цифры,
+// без вывода прописью, без дробного числа) не выделяет память, если в b достаточно места.
+func (d *Durafmt) appendTo(b []byte) []byte {
+	// Нулевое значение Durafmt форматируется с настройками по умолчанию.
+	if d.options == nil {
+		c := *d
+		c.options = &defaultFormatter.options
+		d = &c
 	}
 
-	duration, err := time.ParseDuration(input)
-	if err != nil {
-		return nil, err
+	if d.predicate != "" {
+		return d.appendPredicate(b)
 	}
 
-	return &Durafmt{duration, input, 1, ""}, nil
-}
+	if d.adjective != "" {
+		return d.appendPeriod(b)
+	}
 
-// String форматирует *Durafmt в человекочитаемый вид.
-func (d *Durafmt) String() string {
-	var duration string
+	// Форматирование не меняет *Durafmt: дальше используется модуль продолжительности abs.
+	abs, negative := d.abs()
 
 	// Check for minus durations.
-	if string(d.input[0]) == "-" {
-		duration += "-"
-		d.duration = -d.duration
+	if negative {
+		if d.spelled() {
+			b = append(b, "минус "...)
+		} else {
+			b = append(b, '-')
+		}
 	}
 
-	var microseconds int64
-	var milliseconds int64
-	var seconds int64
-	var minutes int64
-	var hours int64
-	var days int64
-	var weeks int64
-	var years int64
-
-	shouldConvert := false
-	remainingSecondsToConvert := int64(d.duration / time.Microsecond)
-
-	// Convert duration.
-	if d.limitUnit == "" {
-		shouldConvert = true
+	return d.appendAbs(b, abs, negative)
+}
+
+// appendAbs дописывает в b модуль продолжительности abs (см. abs()) без знака. negative означает
+// отрицательную продолжительность и учитывается при округлении.
+func (d *Durafmt) appendAbs(b []byte, abs time.Duration, negative bool) []byte {
+	if d.decimal {
+		return d.appendDecimal(b, abs)
 	}
 
-	if d.limitUnit == Years || shouldConvert {
-		years = remainingSecondsToConvert / (365 * 24 * 3600 * 1000000)
-		remainingSecondsToConvert -= years * 365 * 24 * 3600 * 1000000
-		shouldConvert = true
+	values := d.durationValues(abs, negative)
+
+	return d.appendDuration(b, &values)
+}
+
+// abs возвращает модуль продолжительности и признак того, что она отрицательная. Модуль math.MinInt64
+// не помещается в time.Duration, поэтому для него возвращается math.MaxInt64, а недостающую
+// наносекунду добавляет fixedValues().
+func (d *Durafmt) abs() (time.Duration, bool) {
+	switch {
+	case d.duration == math.MinInt64:
+		return math.MaxInt64, true
+	case d.duration < 0:
+		return -d.duration, true
+	default:
+		return d.duration, strings.HasPrefix(d.input, "-")
 	}
+}
+
+// durationValues раскладывает модуль продолжительности abs по единицам времени с учётом всех ограничений
+// и округления.
+func (d *Durafmt) durationValues(abs time.Duration, negative bool) durationValues {
+	values := d.roundedDurationValues(abs, negative, d.smallestUnit)
 
-	if d.limitUnit == Weeks || shouldConvert {
-		weeks = remainingSecondsToConvert / (7 * 24 * 3600 * 1000000)
-		remainingSecondsToConvert -= weeks * 7 * 24 * 3600 * 1000000
-		shouldConvert = true
+	// При ограничении количества элементов округляем до младшего из оставшихся элементов.
+	if d.limitN > 0 && d.rounding != "" && d.rounding != RoundTruncate {
+		if idx := nthNonZeroUnit(&values, d.limitN); idx >= 0 {
+			values = d.roundedDurationValues(abs, negative, units[idx])
+		}
 	}
 
-	if d.limitUnit == Days || shouldConvert {
-		days = remainingSecondsToConvert / (24 * 3600 * 1000000)
-		remainingSecondsToConvert -= days * 24 * 3600 * 1000000
-		shouldConvert = true
+	return values
+}
+
+// durationValues хранит значения продолжительности, разложенной по единицам времени, в порядке units.
+type durationValues [len(units)]int64
+
+// fixedDurationValues раскладывает продолжительность по единицам времени фиксированной длины,
+// начиная с limitUnit (или с лет, если limitUnit пуст). Единицы из optionalUnits используются,
+// только если они совпадают с limitUnit.
+func fixedDurationValues(duration time.Duration, limitUnit string) durationValues {
+	var values durationValues
+
+	shouldConvert := limitUnit == ""
+	remainingToConvert := duration
+
+	for idx, unit := range units {
+		// Месяцы без привязки к календарю считаются равными 30 дням, поэтому они, как и века,
+		// десятилетия и кварталы, выводятся, только если явно заданы старшей единицей времени.
+		if optionalUnits[idx] && limitUnit != unit {
+			continue
+		}
+
+		// Всё, что осталось, попадает в младшую единицу времени.
+		if unit == limitUnit || shouldConvert || idx == len(units)-1 {
+			size := unitDurations[idx]
+			values[idx] = int64(remainingToConvert / size)
+			remainingToConvert -= time.Duration(values[idx]) * size
+			shouldConvert = true
+		}
 	}
 
-	if d.limitUnit == Hours || shouldConvert {
-		hours = remainingSecondsToConvert / (3600 * 1000000)
-		remainingSecondsToConvert -= hours * 3600 * 1000000
-		shouldConvert = true
+	return values
+}
+
+// appendDuration дописывает в b элементы продолжительности вида "2 часа", от старшей единицы времени
+// к младшей, с учётом LimitFirstN(), разделителя и союза.
+func (d *Durafmt) appendDuration(b []byte, values *durationValues) []byte {
+	shown, count := d.shownUnits(values)
+
+	sep := d.separator
+	if sep == "" {
+		sep = d.lang().Separator()
 	}
 
-	if d.limitUnit == Minutes || shouldConvert {
-		minutes = remainingSecondsToConvert / (60 * 1000000)
-		remainingSecondsToConvert -= minutes * 60 * 1000000
-		shouldConvert = true
+	for i := 0; i < count; i++ {
+		switch {
+		case i == 0:
+		case i == count-1 && d.conjunction != "":
+			if d.serial {
+				b = append(b, sep...)
+			} else {
+				b = append(b, ' ')
+			}
+
+			b = append(b, d.conjunction...)
+			b = append(b, ' ')
+		default:
+			b = append(b, sep...)
+		}
+
+		b = d.appendUnit(b, units[shown[i]], values[shown[i]])
 	}
 
-	if d.limitUnit == Seconds || shouldConvert {
-		seconds = remainingSecondsToConvert / 1000000
-		remainingSecondsToConvert -= seconds * 1000000
-		shouldConvert = true
+	return b
+}
+
+// shownUnits возвращает индексы выводимых единиц времени и их количество с учётом LimitFirstN().
+func (d *Durafmt) shownUnits(values *durationValues) ([len(units)]int, int) {
+	var shown [len(units)]int
+
+	count := 0
+	smallestIdx := d.workUnitIndex(unitIndex(d.smallestUnit))
+	zeroIdx := d.zeroUnitIndex()
+
+	for idx := range units {
+		if smallestIdx >= 0 && idx > smallestIdx {
+			break
+		}
+
+		// Пропускаем любой элемент со значением 0, кроме нулевой продолжительности из ввода вида "0s".
+		if values[idx] != 0 || idx == zeroIdx {
+			shown[count] = idx
+			count++
+		}
 	}
 
-	if d.limitUnit == Milliseconds || shouldConvert {
-		milliseconds = remainingSecondsToConvert / 1000
-		remainingSecondsToConvert -= milliseconds * 1000
+	// Если всё округлилось до нуля, выводим ноль в минимальной единице времени.
+	if count == 0 && smallestIdx >= 0 {
+		shown[count] = smallestIdx
+		count++
 	}
 
-	microseconds = remainingSecondsToConvert
-
-	// Create a map of the converted duration time.
-	durationMap := map[string]int64{
-		Microseconds: microseconds,
-		Milliseconds: milliseconds,
-		Seconds:      seconds,
-		Minutes:      minutes,
-		Hours:        hours,
-		Days:         days,
-		Weeks:        weeks,
-		Years:        years,
+	// Если запрошена краткая версия, оставляем только первые limitN элементов.
+	if d.limitN > 0 && count > d.limitN {
+		count = d.limitN
 	}
 
-	duration += d.buildDuration(durationMap)
+	return shown, count
+}
+
+// zeroUnitIndex возвращает индекс единицы времени, в которой выводится нулевая продолжительность,
+// или -1 для ненулевой. Для ввода вида "0m" это минуты, иначе — секунды, как у time.Duration(0).String().
+func (d *Durafmt) zeroUnitIndex() int {
+	if d.duration != 0 {
+		return -1
+	}
 
-	// Если запрошена краткая версия и в строке более limitN*2 пробелов, возвращаем
-	// первые limitN*2 подстроки.
-	if d.limitN > 0 {
-		parts := strings.Split(duration, " ")
+	input := strings.TrimPrefix(d.input, "-")
+	if input == "" || input == "0" {
+		return unitIndex(Seconds)
+	}
 
-		if len(parts) > d.limitN*2 {
-			duration = strings.Join(parts[:d.limitN*2], " ")
+	for idx := range unitsShort {
+		if len(input) == len(unitsShort[idx])+1 && input[0] == '0' && input[1:] == unitsShort[idx] {
+			return idx
 		}
 	}
 
-	return duration
+	return -1
 }
 
-func (d *Durafmt) buildDuration(durationMap map[string]int64) string {
-	duration := ""
+// appendUnit дописывает в b количество v единиц времени unit, например "2 часа" или "две минуты".
+func (d *Durafmt) appendUnit(b []byte, unit string, v int64) []byte {
+	if abbr := d.unitAbbr(unit); abbr != "" {
+		b = strconv.AppendInt(b, v, 10)
+		if d.style != StyleNarrow {
+			b = append(b, ' ')
+		}
 
-	// Construct duration string.
-	for idx := range units {
-		uKey := units[idx]
-		u := unitNames[uKey]
-		v := durationMap[uKey]
-		strval := strconv.FormatInt(v, 10)
+		return append(b, abbr...)
+	}
 
-		if d.duration.String() == "0" || d.duration.String() == "0s" {
-			pattern := fmt.Sprintf("^-?0%s$", unitsShort[idx])
+	if !d.spelled() {
+		b = strconv.AppendInt(b, v, 10)
+		b = append(b, ' ')
 
-			isMatch, err := regexp.MatchString(pattern, d.input)
-			if err != nil {
-				return ""
-			}
+		return d.appendUnitName(b, d.gramCase, unit, d.lang().PluralCategory(v))
+	}
 
-			if isMatch {
-				duration += strval + " " + u[Many]
-			}
-		}
+	b = append(b, spellNumber(v, d.unitGender(unit), d.gramCase)...)
+	b = append(b, ' ')
 
-		if v == 0 {
-			// Пропускаем любой элемент со значением 0.
-			continue
-		}
+	// После нуля и круглых тысяч, миллионов и т.д. существительное стоит в родительном падеже
+	// множественного числа в любом падеже числительного: "с двумя тысячами минут".
+	if v%1000 == 0 {
+		return d.appendUnitName(b, Genitive, unit, Many)
+	}
 
-		switch v % 10 {
-		case 1:
-			if v%100 == 11 {
-				duration += strval + " " + u[Many] + " "
-			} else {
-				duration += strval + " " + u[Singular] + " "
-			}
+	return d.appendUnitName(b, d.gramCase, unit, pluralCategory(v))
+}
 
-		case 2, 3, 4:
-			if v%100 == 12 || v%100 == 13 || v%100 == 14 {
-				duration += strval + " " + u[Many] + " "
-			} else {
-				duration += strval + " " + u[Some] + " "
-			}
+// spelled сообщает, выводятся ли количества прописью с учётом выбранного стиля.
+func (d *Durafmt) spelled() bool {
+	return d.words && abbrStyleIndex(d.style) < 0 && d.russian()
+}
 
-		default:
-			duration += strval + " " + u[Many] + " "
-		}
+// pluralCategory возвращает тип числа (Singular, Some или Many), с которым согласуется
+// название единицы времени для количества v.
+func pluralCategory(v int64) string {
+	if v < 0 {
+		v = -v
 	}
 
-	// Удаляем лишние пробелы.
-	return strings.TrimSpace(duration)
+	switch v % 10 {
+	case 1:
+		if v%100 == 11 {
+			return Many
+		}
+
+		return Singular
+	case 2, 3, 4:
+		if v%100 == 12 || v%100 == 13 || v%100 == 14 {
+			return Many
+		}
+
+		return Some
+	default:
+		return Many
+	}
 }
@@ -2,6 +2,8 @@ package durufmt
 
 import (
 	"fmt"
+	"math"
+	"sync"
 	"testing"
 	"time"
 )
@@ -97,6 +99,12 @@ func TestParseWithLimitToUnit(t *testing.T) {
 		{87593183 * time.Second, Weeks, "144 недели 5 дней 19 часов 26 минут 23 секунды"},
 		{87593183 * time.Second, Years, "2 года 40 недель 3 дня 19 часов 26 минут 23 секунды"},
 		{87593183 * time.Second, "", "2 года 40 недель 3 дня 19 часов 26 минут 23 секунды"},
+		{87593183 * time.Second, Quarters, "11 кварталов 3 недели 2 дня 19 часов 26 минут 23 секунды"},
+		{87593183 * time.Second, Decades, "2 года 40 недель 3 дня 19 часов 26 минут 23 секунды"},
+		{1<<63 - 1, Centuries, "2 века 92 года 24 недели 3 дня 23 часа 47 минут 16 секунд 854 миллисекунды " +
+			"775 микросекунд 807 наносекунд"},
+		{1<<63 - 1, Decades, "29 десятилетий 2 года 24 недели 3 дня 23 часа 47 минут 16 секунд 854 миллисекунды " +
+			"775 микросекунд 807 наносекунд"},
 	}
 
 	for _, table := range testTimesWithLimitUnit {
@@ -108,6 +116,41 @@ func TestParseWithLimitToUnit(t *testing.T) {
 	}
 }
 
+// TestOptionalUnits тестирует вывод веков, десятилетий и кварталов.
+func TestOptionalUnits(t *testing.T) {
+	year := 365 * 24 * time.Hour
+
+	testOptional := []struct {
+		name     string
+		test     *Durafmt
+		expected string
+	}{
+		{"без LimitToUnit", Parse(200 * year), "200 лет"},
+		{"века", Parse(200 * year).LimitToUnit(Centuries), "2 века"},
+		{"десятилетия", Parse(30 * year).LimitToUnit(Decades), "3 десятилетия"},
+		{"десятилетия прописью", Parse(10 * year).LimitToUnit(Decades).InWords(true), "одно десятилетие"},
+		{
+			"десятилетия, творительный падеж",
+			Parse(10 * year).LimitToUnit(Decades).InCase(Instrumental),
+			"1 десятилетием",
+		},
+		{"кварталы", Parse(180 * 24 * time.Hour).LimitToUnit(Quarters), "2 квартала"},
+		{"века, StyleShort", Parse(100 * year).LimitToUnit(Centuries).InStyle(StyleShort), "1 век"},
+		{"кварталы, StyleShort", Parse(90 * 24 * time.Hour).LimitToUnit(Quarters).InStyle(StyleShort), "1 кв"},
+		{"десятилетия, дробное число", Parse(15*year).Decimal(Decades, 1), "1,5 десятилетия"},
+		{"кварталы, английский", Parse(180 * 24 * time.Hour).LimitToUnit(Quarters).InLocale(English), "2 quarters"},
+		{"века, украинский", Parse(100 * year).LimitToUnit(Centuries).InLocale(Ukrainian), "1 століття"},
+	}
+
+	for _, table := range testOptional {
+		t.Run(table.name, func(t *testing.T) {
+			if result := table.test.String(); result != table.expected {
+				t.Errorf("String() = %q, ожидалось %q", result, table.expected)
+			}
+		})
+	}
+}
+
 func TestParseWithLimitN(t *testing.T) {
 	testTimesWithLimit = []struct {
 		test     time.Duration
@@ -202,6 +245,117 @@ func TestParseShort(t *testing.T) {
 	}
 }
 
+// TestParseNanoseconds тестирует форматирование продолжительностей с наносекундами.
+func TestParseNanoseconds(t *testing.T) {
+	testTimesNano := []struct {
+		test      time.Duration
+		limitUnit string
+		limitN    int
+		expected  string
+	}{
+		{1 * time.Nanosecond, "", 0, "1 наносекунда"},
+		{2 * time.Nanosecond, "", 0, "2 наносекунды"},
+		{999 * time.Nanosecond, "", 0, "999 наносекунд"},
+		{1500 * time.Nanosecond, "", 0, "1 микросекунда 500 наносекунд"},
+		{-21 * time.Nanosecond, "", 0, "-21 наносекунда"},
+		{time.Second + 5*time.Nanosecond, "", 0, "1 секунда 5 наносекунд"},
+		{time.Second + 5*time.Nanosecond, "", 1, "1 секунда"},
+		{1234567 * time.Nanosecond, Microseconds, 0, "1234 микросекунды 567 наносекунд"},
+		{1234567 * time.Nanosecond, Nanoseconds, 0, "1234567 наносекунд"},
+		{1234567 * time.Nanosecond, Nanoseconds, 1, "1234567 наносекунд"},
+	}
+
+	for _, table := range testTimesNano {
+		result := Parse(table.test).LimitToUnit(table.limitUnit).LimitFirstN(table.limitN).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).LimitToUnit(%q).LimitFirstN(%d).String() = %q, ожидалось %q",
+				table.test, table.limitUnit, table.limitN, result, table.expected)
+		}
+	}
+
+	d, err := ParseString("0ns")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result := d.String(); result != "0 наносекунд" {
+		t.Errorf("ParseString(\"0ns\").String() = %q, ожидалось %q", result, "0 наносекунд")
+	}
+
+	if result := Parse(1500 * time.Nanosecond).InStyle(StyleShort).String(); result != "1 мкс 500 нс" {
+		t.Errorf("Parse(1500ns).InStyle(StyleShort).String() = %q, ожидалось %q", result, "1 мкс 500 нс")
+	}
+}
+
+// TestParseInStyle тестирует вывод сокращённых названий единиц времени.
+func TestParseInStyle(t *testing.T) {
+	testTimesInStyle := []struct {
+		test     time.Duration
+		style    string
+		expected string
+	}{
+		{(2 * time.Hour) + (15 * time.Minute) + (3 * time.Second), "", "2 часа 15 минут 3 секунды"},
+		{(2 * time.Hour) + (15 * time.Minute) + (3 * time.Second), StyleFull, "2 часа 15 минут 3 секунды"},
+		{(2 * time.Hour) + (15 * time.Minute) + (3 * time.Second), StyleShort, "2 ч 15 мин 3 с"},
+		{(2 * time.Hour) + (15 * time.Minute) + (3 * time.Second), StyleNarrow, "2ч 15м 3с"},
+		{17519 * time.Hour, StyleShort, "1 г 52 нед 23 ч"},
+		{48 * time.Hour, StyleNarrow, "2д"},
+		{1001001 * time.Microsecond, StyleShort, "1 с 1 мс 1 мкс"},
+		{-100 * time.Second, StyleShort, "-1 мин 40 с"},
+	}
+
+	for _, table := range testTimesInStyle {
+		result := Parse(table.test).InStyle(table.style).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).InStyle(%q).String() = %q. получено %q, ожидалось %q",
+				table.test, table.style, result, result, table.expected)
+		}
+	}
+
+	result := Parse(17519 * time.Hour).InStyle(StyleNarrow).LimitFirstN(2).String()
+	if result != "1г 52н" {
+		t.Errorf("Parse(17519h).InStyle(StyleNarrow).LimitFirstN(2).String() = %q, ожидалось %q", result, "1г 52н")
+	}
+
+	result = Parse(-2 * time.Hour).InStyle(StyleShort).InWords(true).InCase(Genitive).String()
+	if result != "-2 ч" {
+		t.Errorf("Parse(-2h).InStyle(StyleShort).InWords(true).String() = %q, ожидалось %q", result, "-2 ч")
+	}
+}
+
+// TestParseWithSeparators тестирует разделители и союзы между элементами продолжительности.
+func TestParseWithSeparators(t *testing.T) {
+	timeduration := (2 * time.Hour) + (5 * time.Minute) + (3 * time.Second)
+
+	testSeparators := []struct {
+		test        time.Duration
+		separator   string
+		conjunction string
+		serial      bool
+		limitN      int
+		expected    string
+	}{
+		{timeduration, "", "", false, 0, "2 часа 5 минут 3 секунды"},
+		{timeduration, ", ", "", false, 0, "2 часа, 5 минут, 3 секунды"},
+		{timeduration, ", ", "и", false, 0, "2 часа, 5 минут и 3 секунды"},
+		{timeduration, ", ", "и", true, 0, "2 часа, 5 минут, и 3 секунды"},
+		{timeduration, "", "и", false, 0, "2 часа 5 минут и 3 секунды"},
+		{timeduration, ", ", "и", false, 2, "2 часа и 5 минут"},
+		{timeduration, ", ", "и", true, 1, "2 часа"},
+		{timeduration, " / ", "", false, 2, "2 часа / 5 минут"},
+		{-100 * time.Second, ", ", "и", false, 0, "-1 минута и 40 секунд"},
+	}
+
+	for _, table := range testSeparators {
+		result := Parse(table.test).Separator(table.separator).
+			Conjunction(table.conjunction, table.serial).LimitFirstN(table.limitN).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).Separator(%q).Conjunction(%q, %t).LimitFirstN(%d).String() = %q, ожидалось %q",
+				table.test, table.separator, table.conjunction, table.serial, table.limitN, result, table.expected)
+		}
+	}
+}
+
 // TestParseString тестирует конвертирование строковых входных данных.
 func TestParseString(t *testing.T) {
 	testStrings = []struct {
@@ -388,6 +542,128 @@ func TestParseStringShort(t *testing.T) {
 	}
 }
 
+// TestStringIsIdempotent проверяет, что повторный вызов String() не меняет результат.
+func TestStringIsIdempotent(t *testing.T) {
+	d := Parse(-100 * time.Second)
+
+	first := d.String()
+	second := d.String()
+
+	if first != "-1 минута 40 секунд" || second != first {
+		t.Errorf("d.String() = %q, затем %q, ожидалось %q оба раза", first, second, "-1 минута 40 секунд")
+	}
+
+	if d.Duration() != -100*time.Second {
+		t.Errorf("d.Duration() = %v после String(), ожидалось %v", d.Duration(), -100*time.Second)
+	}
+
+	if result := d.Relative(); result != "1 минуту 40 секунд назад" {
+		t.Errorf("d.Relative() = %q после String(), ожидалось %q", result, "1 минуту 40 секунд назад")
+	}
+}
+
+// TestSettersReturnCopy проверяет, что методы настройки не меняют исходную структуру.
+func TestSettersReturnCopy(t *testing.T) {
+	d := Parse(17519 * time.Hour)
+	short := d.LimitFirstN(1).InStyle(StyleShort)
+
+	if result := d.String(); result != "1 год 52 недели 23 часа" {
+		t.Errorf("d.String() = %q, ожидалось %q", result, "1 год 52 недели 23 часа")
+	}
+
+	if result := short.String(); result != "1 г" {
+		t.Errorf("short.String() = %q, ожидалось %q", result, "1 г")
+	}
+}
+
+// TestZeroDurafmt проверяет, что нулевое значение Durafmt форматируется с настройками по умолчанию.
+func TestZeroDurafmt(t *testing.T) {
+	var d Durafmt
+
+	if result := d.String(); result != "0 секунд" {
+		t.Errorf("d.String() = %q, ожидалось %q", result, "0 секунд")
+	}
+
+	if result := d.InStyle(StyleShort).String(); result != "0 с" {
+		t.Errorf("d.InStyle(StyleShort).String() = %q, ожидалось %q", result, "0 с")
+	}
+
+	if result := d.Relative(); result != "только что" {
+		t.Errorf("d.Relative() = %q, ожидалось %q", result, "только что")
+	}
+}
+
+// TestExtremeDurations проверяет вывод math.MinInt64, модуль которого не помещается в time.Duration,
+// и округление math.MaxInt64 вверх.
+func TestExtremeDurations(t *testing.T) {
+	const (
+		maxRest = " 24 недели 3 дня 23 часа 47 минут 16 секунд 854 миллисекунды 775 микросекунд 807 наносекунд"
+		minRest = " 24 недели 3 дня 23 часа 47 минут 16 секунд 854 миллисекунды 775 микросекунд 808 наносекунд"
+	)
+
+	testExtremes := []struct {
+		name     string
+		test     *Durafmt
+		expected string
+	}{
+		{"MaxInt64", Parse(math.MaxInt64), "292 года" + maxRest},
+		{"MinInt64", Parse(math.MinInt64), "-292 года" + minRest},
+		{
+			"MinInt64 прописью",
+			Parse(math.MinInt64).InWords(true).LimitFirstN(2),
+			"минус двести девяносто два года двадцать четыре недели",
+		},
+		{
+			"MinInt64, округление до микросекунд",
+			Parse(math.MinInt64).LimitToSmallestUnit(Microseconds).Round(RoundHalfUp),
+			"-292 года 24 недели 3 дня 23 часа 47 минут 16 секунд 854 миллисекунды 776 микросекунд",
+		},
+		{"MaxInt64, RoundCeil", Parse(math.MaxInt64).LimitFirstN(1).Round(RoundCeil), "293 года"},
+		{"MinInt64, RoundFloor", Parse(math.MinInt64).LimitFirstN(1).Round(RoundFloor), "-293 года"},
+		{"MinInt64, RoundCeil", Parse(math.MinInt64).LimitFirstN(1).Round(RoundCeil), "-292 года"},
+	}
+
+	for _, table := range testExtremes {
+		t.Run(table.name, func(t *testing.T) {
+			if result := table.test.String(); result != table.expected {
+				t.Errorf("String() = %q, ожидалось %q", result, table.expected)
+			}
+		})
+	}
+
+	if result := Relative(math.MinInt64); result != "292 года"+minRest+" назад" {
+		t.Errorf("Relative(math.MinInt64) = %q, ожидалось %q", result, "292 года"+minRest+" назад")
+	}
+}
+
+// TestConcurrentString проверяет, что одну структуру можно форматировать из нескольких горутин.
+// Запускайте с флагом -race.
+func TestConcurrentString(t *testing.T) {
+	d := Parse(-(354 * time.Hour) - (22 * time.Minute) - (3 * time.Second))
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+
+		go func(n int) {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				if result := d.String(); result != "-2 недели 18 часов 22 минуты 3 секунды" {
+					t.Errorf("d.String() = %q", result)
+				}
+
+				if result := d.LimitFirstN(n%3 + 1).InCase(Accusative).Relative(); result == "" {
+					t.Error("d.Relative() вернул пустую строку")
+				}
+			}
+		}(i)
+	}
+
+	wg.Wait()
+}
+
 // TestInvalidDuration for invalid inputs.
 func TestInvalidDuration(t *testing.T) {
 	testStrings = []struct {
@@ -423,6 +699,30 @@ func TestInvalidDuration(t *testing.T) {
 	}
 }
 
+// TestAppendFormatDoesNotAllocate тестирует, что AppendFormat() не выделяет память при достаточной ёмкости буфера.
+func TestAppendFormatDoesNotAllocate(t *testing.T) {
+	formatters := []*Formatter{
+		NewFormatter(),
+		NewFormatter(WithLimitFirstN(2)),
+		NewFormatter(WithStyle(StyleShort), WithSeparator(", ")),
+		NewFormatter(WithLimitToSmallestUnit(Minutes), WithRounding(RoundHalfUp)),
+		NewFormatter(WithCase(Genitive), WithConjunction("и", false)),
+	}
+	durations := []time.Duration{0, 42 * time.Second, -(26*time.Hour + 3*time.Minute + 7*time.Millisecond), 1<<63 - 1}
+	buf := make([]byte, 0, 256)
+
+	for _, f := range formatters {
+		for _, d := range durations {
+			allocs := testing.AllocsPerRun(100, func() {
+				buf = f.AppendFormat(buf[:0], d)
+			})
+			if allocs != 0 {
+				t.Errorf("%v: AppendFormat выделяет память %v раз, ожидалось 0", d, allocs)
+			}
+		}
+	}
+}
+
 // Benchmarks
 
 func BenchmarkParse(b *testing.B) {
@@ -442,3 +742,45 @@ func BenchmarkParseString(b *testing.B) {
 		ParseString(fmt.Sprintf("%dh", n))
 	}
 }
+
+func BenchmarkString(b *testing.B) {
+	d := Parse(354*time.Hour + 22*time.Minute + 3*time.Second + 12*time.Millisecond)
+
+	b.ReportAllocs()
+
+	for n := 0; n < b.N; n++ {
+		_ = d.String()
+	}
+}
+
+func BenchmarkFormatterFormat(b *testing.B) {
+	f := NewFormatter(WithLimitFirstN(2))
+
+	b.ReportAllocs()
+
+	for n := 0; n < b.N; n++ {
+		_ = f.Format(time.Duration(n) * time.Second)
+	}
+}
+
+func BenchmarkFormatterAppendFormat(b *testing.B) {
+	f := NewFormatter(WithLimitFirstN(2))
+	buf := make([]byte, 0, 128)
+
+	b.ReportAllocs()
+
+	for n := 0; n < b.N; n++ {
+		buf = f.AppendFormat(buf[:0], time.Duration(n)*time.Second)
+	}
+}
+
+func BenchmarkFormatterAppendFormatZero(b *testing.B) {
+	f := NewFormatter()
+	buf := make([]byte, 0, 128)
+
+	b.ReportAllocs()
+
+	for n := 0; n < b.N; n++ {
+		buf = f.AppendFormat(buf[:0], 0)
+	}
+}
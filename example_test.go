@@ -78,3 +78,139 @@ func ExampleParseShort() {
 
 	fmt.Println(duration) // 2 недели
 }
+
+// Вывод единиц времени в винительном падеже, например, для фразы "через ...".
+func ExampleDurafmt_InCase() {
+	timeduration := (2 * 168 * time.Hour) + time.Minute
+	duration := Parse(timeduration).InCase(Accusative).String()
+
+	fmt.Println("через " + duration) // через 2 недели 1 минуту
+}
+
+func ExampleRelative() {
+	fmt.Println(Relative(5 * time.Minute))  // через 5 минут
+	fmt.Println(Relative(-3 * time.Hour))   // 3 часа назад
+	fmt.Println(Relative(-2 * time.Second)) // только что
+}
+
+// Вывод количеств прописью, например, для синтеза речи.
+func ExampleDurafmt_InWords() {
+	duration := Parse(21 * time.Minute).InWords(true).String()
+
+	fmt.Println(duration) // двадцать одна минута
+}
+
+// Сокращённые названия единиц времени для узких колонок таблиц.
+func ExampleDurafmt_InStyle() {
+	timeduration := (2 * time.Hour) + (15 * time.Minute) + (3 * time.Second)
+
+	fmt.Println(Parse(timeduration).InStyle(StyleShort))  // 2 ч 15 мин 3 с
+	fmt.Println(Parse(timeduration).InStyle(StyleNarrow)) // 2ч 15м 3с
+}
+
+// Вывод продолжительности в виде перечисления с союзом "и".
+func ExampleDurafmt_Conjunction() {
+	timeduration := (2 * time.Hour) + (5 * time.Minute) + (3 * time.Second)
+	duration := Parse(timeduration).Separator(", ").Conjunction("и", false)
+
+	fmt.Println(duration) // 2 часа, 5 минут и 3 секунды
+}
+
+// Разбор продолжительности, записанной пользователем по-русски.
+func ExampleParseRussian() {
+	duration, err := ParseRussian("полтора часа и 5 минут")
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(duration.Duration()) // 1h35m0s
+	fmt.Println(duration)            // 1 час 35 минут
+}
+
+// Раскладка промежутка между двумя датами по календарю.
+func ExampleBetween() {
+	from := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 400)
+
+	fmt.Println(Between(from, to))   // 1 год 1 месяц 4 дня
+	fmt.Println(Parse(to.Sub(from))) // 1 год 5 недель
+}
+
+// Ограничение минимальной единицы времени с округлением.
+func ExampleDurafmt_LimitToSmallestUnit() {
+	timeduration := (1 * time.Hour) + (59 * time.Minute) + (31 * time.Second)
+
+	fmt.Println(Parse(timeduration).LimitToSmallestUnit(Minutes))                    // 1 час 59 минут
+	fmt.Println(Parse(timeduration).LimitToSmallestUnit(Minutes).Round(RoundHalfUp)) // 2 часа
+}
+
+// Вывод продолжительности одним дробным числом.
+func ExampleDurafmt_Decimal() {
+	duration := Parse(90*time.Minute).Decimal(Hours, 1)
+
+	fmt.Println("списано за " + duration.String()) // списано за 1,5 часа
+}
+
+// Один раз настроенный Formatter можно использовать для любого количества значений.
+func ExampleFormatter() {
+	formatter := NewFormatter(WithStyle(StyleShort), WithLimitFirstN(2))
+
+	fmt.Println(formatter.Format((354 * time.Hour) + (22 * time.Minute))) // 2 нед 18 ч
+	fmt.Println(formatter.Format(90 * time.Second))                       // 1 мин 30 с
+}
+
+// Вывод на другом языке.
+func ExampleDurafmt_InLocale() {
+	timeduration := (354 * time.Hour) + (22 * time.Minute) + (3 * time.Second)
+
+	fmt.Println(Parse(timeduration).InLocale(English))                      // 2 weeks 18 hours 22 minutes 3 seconds
+	fmt.Println(Parse(timeduration).InLocale(English).InStyle(StyleNarrow)) // 2w 18h 22m 3s
+}
+
+// Вывод дней как суток.
+func ExampleDurafmt_DayUnit() {
+	duration := Parse(50 * time.Hour).DayUnit(DayUnitSutki)
+
+	fmt.Println(duration)               // 2 суток 2 часа
+	fmt.Println(duration.InWords(true)) // двое суток два часа
+}
+
+// Глагол, согласованный с продолжительностью.
+func ExampleDurafmt_Predicate() {
+	fmt.Println(Parse(time.Minute).Predicate("осталось"))     // осталась 1 минута
+	fmt.Println(Parse(5 * time.Minute).Predicate("осталось")) // осталось 5 минут
+	fmt.Println(Parse(time.Minute).Predicate("займёт"))       // займёт 1 минуту
+}
+
+func ExampleDurafmt_Period() {
+	fmt.Println(Parse(time.Hour).Period("за", "последний"))                    // за последний час
+	fmt.Println(Parse(3*24*time.Hour).Period("за", "последний"))               // за последние 3 дня
+	fmt.Println(Parse(5*time.Minute).Period("в течение", "ближайший"))         // в течение ближайших 5 минут
+	fmt.Println(Parse(7*24*time.Hour).Period("", "каждый").InCase(Accusative)) // каждую неделю
+}
+
+func ExampleFormatter_FormatRange() {
+	fmt.Println(FormatRange(2*time.Hour, 3*time.Hour)) // 2–3 часа
+
+	f := NewFormatter(WithRangeStyle(RangeFromTo))
+	fmt.Println(f.FormatRange(2*time.Minute, 5*time.Minute)) // от 2 до 5 минут
+}
+
+func ExampleFormatter_Compare() {
+	fmt.Println(Compare(10*time.Minute, 15*time.Minute)) // на 5 минут быстрее
+	fmt.Println(Compare(5*time.Hour, 3*time.Hour))       // на 2 часа дольше
+
+	f := NewFormatter(WithComparison(CompareRatio))
+	fmt.Println(f.Compare(3*time.Minute, time.Minute)) // в 3 раза медленнее
+}
+
+func ExampleWorkCalendar_Between() {
+	cal := NewWorkCalendar(9*time.Hour, 18*time.Hour)
+	_ = cal.LoadFile("testdata/calendar_2024.txt")
+
+	msk := time.FixedZone("MSK", 3*60*60)
+	from := time.Date(2024, time.April, 26, 9, 0, 0, 0, msk)
+	to := time.Date(2024, time.May, 3, 13, 0, 0, 0, msk)
+
+	fmt.Println(cal.Between(from, to)) // 3 рабочих дня 4 рабочих часа
+}
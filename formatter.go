@@ -0,0 +1,203 @@
+package durufmt
+
+import (
+	"errors"
+	"time"
+)
+
+// options хранит настройки форматирования, общие для Formatter и Durafmt.
+type options struct {
+	limitN       int    // В случае ненулевого значения ограничивает количество выдаваемых элементов в результате.
+	limitUnit    string // Непустое значение лимитирует максимальную единицу времени для выдачи.
+	smallestUnit string // Непустое значение лимитирует минимальную единицу времени для выдачи.
+	rounding     string // Способ округления минимальной единицы времени. Пустое значение — RoundTruncate.
+	gramCase     string // Падеж, в котором выводятся названия единиц времени. Пустое значение — именительный.
+
+	style       string // Стиль названий единиц времени. Пустое значение — StyleFull.
+	words       bool   // Выводить количества прописью: "две минуты" вместо "2 минуты".
+	separator   string // Разделитель элементов. Пустое значение — пробел.
+	conjunction string // Союз перед последним элементом, например "и". Пустое значение — без союза.
+	serial      bool   // Ставить разделитель и перед союзом: "2 часа, 5 минут, и 3 секунды".
+
+	decimal     bool   // Выводить продолжительность одним дробным числом: "1,5 часа".
+	decimalUnit string // Единица времени дробного числа. Пустое значение — наибольшая подходящая.
+	precision   int    // Количество знаков после запятой у дробного числа.
+
+	thresholds *RelativeThresholds // Пороги для Relative(). nil означает DefaultRelativeThresholds.
+	locale     Locale              // Язык вывода. nil означает Russian.
+	dayUnit    string              // Вариант названия дня. Пустое значение — DayUnitDays.
+	predicate  string              // Глагол-сказуемое перед продолжительностью. Пустое значение — без глагола.
+
+	preposition string // Предлог периода, например "за".
+	adjective   string // Прилагательное периода, например "последний". Пустое значение — без периода.
+	rangeStyle  string // Стиль вывода диапазона. Пустое значение — RangeDash.
+	comparison  string // Стиль сравнения продолжительностей. Пустое значение — CompareDifference.
+
+	workDay time.Duration // Длина рабочего дня для вывода рабочего времени. 0 означает обычное время.
+}
+
+// Option настраивает Formatter.
+type Option func(*options)
+
+// WithLimitFirstN ограничивает количество элементов в результате, см. Durafmt.LimitFirstN().
+func WithLimitFirstN(n int) Option {
+	return func(o *options) { o.limitN = n }
+}
+
+// WithLimitToUnit ограничивает максимальную единицу времени, см. Durafmt.LimitToUnit().
+func WithLimitToUnit(unit string) Option {
+	return func(o *options) { o.limitUnit = unit }
+}
+
+// WithLimitToSmallestUnit ограничивает минимальную единицу времени, см. Durafmt.LimitToSmallestUnit().
+func WithLimitToSmallestUnit(unit string) Option {
+	return func(o *options) { o.smallestUnit = unit }
+}
+
+// WithRounding устанавливает способ округления, см. Durafmt.Round().
+func WithRounding(mode string) Option {
+	return func(o *options) { o.rounding = mode }
+}
+
+// WithCase устанавливает падеж названий единиц времени, см. Durafmt.InCase().
+func WithCase(grammaticalCase string) Option {
+	return func(o *options) { o.gramCase = grammaticalCase }
+}
+
+// WithStyle устанавливает стиль названий единиц времени, см. Durafmt.InStyle().
+func WithStyle(style string) Option {
+	return func(o *options) { o.style = style }
+}
+
+// WithWords включает вывод количеств прописью, см. Durafmt.InWords().
+func WithWords(words bool) Option {
+	return func(o *options) { o.words = words }
+}
+
+// WithSeparator устанавливает разделитель элементов, см. Durafmt.Separator().
+func WithSeparator(sep string) Option {
+	return func(o *options) { o.separator = sep }
+}
+
+// WithConjunction устанавливает союз перед последним элементом, см. Durafmt.Conjunction().
+func WithConjunction(conj string, serial bool) Option {
+	return func(o *options) {
+		o.conjunction = conj
+		o.serial = serial
+	}
+}
+
+// WithDecimal включает вывод одним дробным числом, см. Durafmt.Decimal().
+func WithDecimal(unit string, precision int) Option {
+	return func(o *options) {
+		o.decimal = true
+		o.decimalUnit = unit
+		o.precision = precision
+	}
+}
+
+// WithThresholds устанавливает пороги для Relative(), см. Durafmt.Thresholds().
+func WithThresholds(thresholds RelativeThresholds) Option {
+	return func(o *options) { o.thresholds = &thresholds }
+}
+
+// WithLocale устанавливает язык вывода, см. Durafmt.InLocale().
+func WithLocale(locale Locale) Option {
+	return func(o *options) { o.locale = locale }
+}
+
+// WithDayUnit устанавливает вариант названия дня, см. Durafmt.DayUnit().
+func WithDayUnit(variant string) Option {
+	return func(o *options) { o.dayUnit = variant }
+}
+
+// WithPredicate добавляет перед продолжительностью согласованный глагол, см. Durafmt.Predicate().
+func WithPredicate(verb string) Option {
+	return func(o *options) { o.predicate = verb }
+}
+
+// WithPeriod выводит продолжительность как период с предлогом и прилагательным, см. Durafmt.Period().
+func WithPeriod(preposition, adjective string) Option {
+	return func(o *options) {
+		o.preposition = preposition
+		o.adjective = adjective
+	}
+}
+
+// WithRangeStyle устанавливает стиль вывода диапазона продолжительностей (RangeDash или RangeFromTo),
+// см. Formatter.FormatRange().
+func WithRangeStyle(style string) Option {
+	return func(o *options) { o.rangeStyle = style }
+}
+
+// WithComparison устанавливает стиль сравнения продолжительностей (CompareDifference или CompareRatio),
+// см. Formatter.Compare().
+func WithComparison(style string) Option {
+	return func(o *options) { o.comparison = style }
+}
+
+// WithWorkDay включает вывод рабочего времени с рабочим днём длиной length, см. Durafmt.WorkDay().
+func WithWorkDay(length time.Duration) Option {
+	return func(o *options) { o.workDay = length }
+}
+
+// Formatter хранит настройки форматирования отдельно от продолжительности, поэтому один раз
+// настроенный Formatter можно использовать для любого количества значений, в том числе из
+// нескольких горутин. Нулевое значение Formatter готово к использованию и форматирует так же, как Parse().
+type Formatter struct {
+	options
+}
+
+var (
+	defaultFormatter = NewFormatter()
+	shortFormatter   = NewFormatter(WithLimitFirstN(1))
+)
+
+// NewFormatter создаёт *Formatter с заданными настройками.
+func NewFormatter(opts ...Option) *Formatter {
+	f := &Formatter{}
+	for _, opt := range opts {
+		opt(&f.options)
+	}
+
+	return f
+}
+
+// Parse создаёт структуру *Durafmt с настройками f.
+func (f *Formatter) Parse(dinput time.Duration) *Durafmt {
+	return &Durafmt{duration: dinput, input: dinput.String(), options: &f.options}
+}
+
+// ParseString создаёт структуру *Durafmt с настройками f из строки. Формат строки аналогичен
+// используемому в durafmt. Возвращает ошибку в случае неправильных входных данных.
+func (f *Formatter) ParseString(input string) (*Durafmt, error) {
+	if input == "0" || input == "-0" {
+		return nil, errors.New("durafmt_ru: не указана единица времени во входном параметре " + input)
+	}
+
+	duration, err := time.ParseDuration(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Durafmt{duration: duration, input: input, options: &f.options}, nil
+}
+
+// Format форматирует продолжительность в человекочитаемый вид.
+func (f *Formatter) Format(dinput time.Duration) string {
+	return string(f.AppendFormat(make([]byte, 0, 64), dinput))
+}
+
+// AppendFormat дописывает отформатированную продолжительность в b и возвращает дополненный срез.
+// Если в b достаточно места, форматирование цифрами не выделяет память, поэтому AppendFormat
+// с переиспользуемым буфером подходит для горячих участков кода, например для логов.
+func (f *Formatter) AppendFormat(b []byte, dinput time.Duration) []byte {
+	d := Durafmt{duration: dinput, options: &f.options}
+
+	return d.appendTo(b)
+}
+
+// Relative форматирует продолжительность как время относительно текущего момента, см. Durafmt.Relative().
+func (f *Formatter) Relative(dinput time.Duration) string {
+	return f.Parse(dinput).Relative()
+}
@@ -0,0 +1,79 @@
+package durufmt
+
+import (
+	"testing"
+	"time"
+)
+
+// TestFormatter тестирует форматирование с помощью заранее настроенного Formatter.
+func TestFormatter(t *testing.T) {
+	timeduration := (354 * time.Hour) + (22 * time.Minute) + (3 * time.Second)
+
+	testFormatters := []struct {
+		formatter *Formatter
+		test      time.Duration
+		expected  string
+	}{
+		{&Formatter{}, timeduration, "2 недели 18 часов 22 минуты 3 секунды"},
+		{NewFormatter(), timeduration, "2 недели 18 часов 22 минуты 3 секунды"},
+		{NewFormatter(WithLimitFirstN(2)), timeduration, "2 недели 18 часов"},
+		{NewFormatter(WithLimitToUnit(Days)), timeduration, "14 дней 18 часов 22 минуты 3 секунды"},
+		{NewFormatter(WithLimitToSmallestUnit(Hours), WithRounding(RoundHalfUp)), timeduration, "2 недели 18 часов"},
+		{NewFormatter(WithLimitFirstN(1), WithRounding(RoundHalfUp)), 90 * time.Minute, "2 часа"},
+		{NewFormatter(WithCase(Accusative)), time.Minute, "1 минуту"},
+		{NewFormatter(WithStyle(StyleShort)), timeduration, "2 нед 18 ч 22 мин 3 с"},
+		{NewFormatter(WithWords(true)), 21 * time.Minute, "двадцать одна минута"},
+		{NewFormatter(WithSeparator(", "), WithConjunction("и", false)), timeduration,
+			"2 недели, 18 часов, 22 минуты и 3 секунды"},
+		{NewFormatter(WithDecimal(Hours, 1)), 90 * time.Minute, "1,5 часа"},
+		{NewFormatter(WithLimitFirstN(1)), -100 * time.Second, "-1 минута"},
+	}
+
+	for _, table := range testFormatters {
+		if result := table.formatter.Format(table.test); result != table.expected {
+			t.Errorf("Format(%q) = %q, ожидалось %q", table.test, result, table.expected)
+		}
+
+		if result := string(table.formatter.AppendFormat([]byte("> "), table.test)); result != "> "+table.expected {
+			t.Errorf("AppendFormat(\"> \", %q) = %q, ожидалось %q", table.test, result, "> "+table.expected)
+		}
+	}
+
+	f := NewFormatter(WithLimitFirstN(1), WithThresholds(RelativeThresholds{}))
+	if result := f.Relative(-26 * time.Hour); result != "1 день назад" {
+		t.Errorf("Relative(-26h) = %q, ожидалось %q", result, "1 день назад")
+	}
+
+	d, err := NewFormatter(WithStyle(StyleNarrow)).ParseString("3h4m5s")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result := d.String(); result != "3ч 4м 5с" {
+		t.Errorf("ParseString(\"3h4m5s\").String() = %q, ожидалось %q", result, "3ч 4м 5с")
+	}
+
+	if _, err := NewFormatter().ParseString("0"); err == nil {
+		t.Error("ParseString(\"0\"): ожидалась ошибка")
+	}
+}
+
+// TestFormatterOptionsShared проверяет, что Durafmt, созданные Formatter, не меняют его настройки.
+func TestFormatterOptionsShared(t *testing.T) {
+	timeduration := (354 * time.Hour) + (22 * time.Minute) + (3 * time.Second)
+	f := NewFormatter(WithLimitFirstN(2))
+	d := f.Parse(timeduration)
+
+	_ = d.LimitFirstN(1).InStyle(StyleShort).Predicate("осталось").Period("за", "последний").String()
+	_ = d.Relative()
+	_ = f.Compare(time.Minute, time.Hour)
+	_ = NewFormatter(WithRangeStyle(RangeFromTo)).FormatRange(time.Hour, 2*time.Hour)
+
+	if result := f.Format(timeduration); result != "2 недели 18 часов" {
+		t.Errorf("Format() = %q, ожидалось %q", result, "2 недели 18 часов")
+	}
+
+	if result := d.String(); result != "2 недели 18 часов" {
+		t.Errorf("d.String() = %q, ожидалось %q", result, "2 недели 18 часов")
+	}
+}
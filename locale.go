@@ -0,0 +1,593 @@
+package durufmt
+
+import (
+	"fmt"
+	"sync"
+)
+
+const (
+	// Категории множественного числа по CLDR в дополнение к Singular, Some и Many.
+	Zero  = "zero"  // Латышский: 0, 10, 11–19, 20, 30... ("10 stundu").
+	Two   = "two"   // Двойственное число, например в словенском.
+	Few   = Some    // Название Some по CLDR: чешский "2 hodiny", литовский "9 valandos".
+	Other = "other" // Остальные случаи: английский "2 hours". В русском используется для дробных чисел: "1,5 часа".
+
+	// Gender — ключ рода (Masculine, Feminine, Neuter или Plural) в названиях единицы времени
+	// в именительном падеже для CustomLocale(), например {Singular: "сутки", ..., Gender: Plural}.
+	Gender = "gender"
+)
+
+// Locale описывает язык, на котором выводятся единицы времени.
+type Locale interface {
+	// PluralCategory возвращает категорию множественного числа (Singular, Some, Many, Other...),
+	// с которой согласуется название единицы времени для количества v.
+	PluralCategory(v int64) string
+	// UnitName возвращает название единицы времени unit в падеже grammaticalCase для категории
+	// множественного числа category.
+	UnitName(grammaticalCase, unit, category string) string
+	// UnitAbbr возвращает сокращённое название единицы времени unit в стиле style или "",
+	// если у стиля нет сокращений.
+	UnitAbbr(style, unit string) string
+	// FractionCategory возвращает категорию множественного числа для дробных чисел ("1,5 часа").
+	FractionCategory() string
+	// Separator возвращает разделитель элементов по умолчанию.
+	Separator() string
+	// DecimalSeparator возвращает разделитель целой и дробной частей для Decimal().
+	DecimalSeparator() string
+}
+
+// LocaleTable — Locale, заданный таблицами. Подходит для регистрации новых языков через RegisterLocale().
+// Таблицы нельзя менять после первого использования: при первом обращении они переводятся в массивы.
+type LocaleTable struct {
+	Plural       func(v int64) string                    // Правило выбора категории множественного числа.
+	Names        map[string]map[string]map[string]string // Падеж → единица времени → категория → название.
+	Abbrs        map[string]map[string]string            // Стиль → единица времени → сокращение.
+	Fraction     string                                  // Категория для дробных чисел. Пустое значение — Other.
+	ElementSep   string                                  // Разделитель элементов. Пустое значение — пробел.
+	DecimalPoint string                                  // Десятичный разделитель. Пустое значение — точка.
+
+	once  sync.Once
+	index *localeIndex
+}
+
+// localeIndex хранит названия и сокращения LocaleTable в массивах в порядке caseOrder, units и categoryOrder,
+// чтобы форматирование не искало их во вложенных map.
+type localeIndex struct {
+	names    [len(caseOrder)][len(units)][len(categoryOrder)]string
+	hasCase  [len(caseOrder)]bool // Есть ли в Names названия в этом падеже.
+	abbrs    [len(abbrStyles)][len(units)]string
+	anyStyle bool // Есть ли в Abbrs сокращения в стилях, которых нет в abbrStyles.
+}
+
+var (
+	// categoryOrder задаёт порядок категорий множественного числа в localeIndex.
+	categoryOrder = [...]string{Singular, Some, Many, Zero, Two, Other}
+	// abbrStyles задаёт порядок стилей с сокращениями в localeIndex.
+	abbrStyles = [...]string{StyleShort, StyleNarrow}
+)
+
+// compiled возвращает таблицы t в виде массивов, при первом вызове собирая их из Names и Abbrs.
+func (t *LocaleTable) compiled() *localeIndex {
+	t.once.Do(func() {
+		index := &localeIndex{}
+
+		for c, gramCase := range caseOrder {
+			forms, ok := t.Names[gramCase]
+			index.hasCase[c] = ok
+
+			for u, unit := range units {
+				for k, category := range categoryOrder {
+					index.names[c][u][k] = forms[unit][category]
+				}
+			}
+		}
+
+		for s, style := range abbrStyles {
+			for u, unit := range units {
+				index.abbrs[s][u] = t.Abbrs[style][unit]
+			}
+		}
+
+		for style := range t.Abbrs {
+			if abbrStyleIndex(style) < 0 {
+				index.anyStyle = true
+			}
+		}
+
+		t.index = index
+	})
+
+	return t.index
+}
+
+// PluralCategory возвращает категорию множественного числа для количества v по правилу t.Plural.
+func (t *LocaleTable) PluralCategory(v int64) string {
+	return t.Plural(v)
+}
+
+// UnitName возвращает название единицы времени. Если для падежа нет названий, используется именительный падеж.
+func (t *LocaleTable) UnitName(grammaticalCase, unit, category string) string {
+	c, u, k := caseNumber(grammaticalCase), unitIndex(unit), categoryIndex(category)
+	if c >= 0 && u >= 0 && k >= 0 {
+		index := t.compiled()
+		if !index.hasCase[c] {
+			c = 0
+		}
+
+		return index.names[c][u][k]
+	}
+
+	// Падежи, единицы времени и категории, которых нет в массивах, ищутся в самих таблицах.
+	forms, ok := t.Names[grammaticalCase]
+	if !ok {
+		forms = t.Names[Nominative]
+	}
+
+	return forms[unit][category]
+}
+
+// UnitAbbr возвращает сокращённое название единицы времени.
+func (t *LocaleTable) UnitAbbr(style, unit string) string {
+	index := t.compiled()
+	s, u := abbrStyleIndex(style), unitIndex(unit)
+
+	switch {
+	case s >= 0 && u >= 0:
+		return index.abbrs[s][u]
+	case s < 0 && !index.anyStyle:
+		return ""
+	default:
+		return t.Abbrs[style][unit]
+	}
+}
+
+// FractionCategory возвращает категорию множественного числа для дробных чисел.
+func (t *LocaleTable) FractionCategory() string {
+	if t.Fraction == "" {
+		return Other
+	}
+
+	return t.Fraction
+}
+
+// Separator возвращает разделитель элементов.
+func (t *LocaleTable) Separator() string {
+	if t.ElementSep == "" {
+		return " "
+	}
+
+	return t.ElementSep
+}
+
+// DecimalSeparator возвращает десятичный разделитель.
+func (t *LocaleTable) DecimalSeparator() string {
+	if t.DecimalPoint == "" {
+		return "."
+	}
+
+	return t.DecimalPoint
+}
+
+var (
+	// Russian — русский язык, используется по умолчанию.
+	Russian = &LocaleTable{
+		Plural:       pluralCategory,
+		Names:        russianNames(),
+		Abbrs:        unitsAbbr,
+		DecimalPoint: ",",
+	}
+
+	// English — английский язык, вывод совпадает с durafmt: "2 weeks 18 hours 22 minutes 3 seconds".
+	English = &LocaleTable{
+		Plural: func(v int64) string {
+			if v == 1 || v == -1 {
+				return Singular
+			}
+
+			return Other
+		},
+		Names: map[string]map[string]map[string]string{
+			Nominative: {
+				Centuries:    {Singular: "century", Other: "centuries"},
+				Decades:      {Singular: "decade", Other: "decades"},
+				Years:        {Singular: "year", Other: "years"},
+				Quarters:     {Singular: "quarter", Other: "quarters"},
+				Months:       {Singular: "month", Other: "months"},
+				Weeks:        {Singular: "week", Other: "weeks"},
+				Days:         {Singular: "day", Other: "days"},
+				Hours:        {Singular: "hour", Other: "hours"},
+				Minutes:      {Singular: "minute", Other: "minutes"},
+				Seconds:      {Singular: "second", Other: "seconds"},
+				Milliseconds: {Singular: "millisecond", Other: "milliseconds"},
+				Microseconds: {Singular: "microsecond", Other: "microseconds"},
+				Nanoseconds:  {Singular: "nanosecond", Other: "nanoseconds"},
+			},
+		},
+		Abbrs: map[string]map[string]string{
+			StyleShort: {
+				Centuries:    "c",
+				Decades:      "dec",
+				Years:        "yr",
+				Quarters:     "qtr",
+				Months:       "mo",
+				Weeks:        "wk",
+				Days:         "d",
+				Hours:        "hr",
+				Minutes:      "min",
+				Seconds:      "s",
+				Milliseconds: "ms",
+				Microseconds: "µs",
+				Nanoseconds:  "ns",
+			},
+			StyleNarrow: {
+				Centuries:    "c",
+				Decades:      "dec",
+				Years:        "y",
+				Quarters:     "q",
+				Months:       "mo",
+				Weeks:        "w",
+				Days:         "d",
+				Hours:        "h",
+				Minutes:      "m",
+				Seconds:      "s",
+				Milliseconds: "ms",
+				Microseconds: "µs",
+				Nanoseconds:  "ns",
+			},
+		},
+	}
+
+	localesMu sync.RWMutex
+	locales   = map[string]Locale{
+		"ru": Russian,
+		"en": English,
+		"uk": Ukrainian,
+		"be": Belarusian,
+		"pl": Polish,
+		"cs": Czech,
+		"sk": Slovak,
+		"lt": Lithuanian,
+		"lv": Latvian,
+	}
+)
+
+// russianNames собирает таблицу названий русского языка из unitNames и unitCases. После дробного
+// числа существительное стоит в родительном падеже единственного числа в любом падеже: "о 2,7 секунды".
+func russianNames() map[string]map[string]map[string]string {
+	names := map[string]map[string]map[string]string{Nominative: unitNames}
+	for gramCase, forms := range unitCases {
+		names[gramCase] = forms
+	}
+
+	for gramCase, forms := range names {
+		withOther := make(map[string]map[string]string, len(forms))
+
+		for unit, categories := range forms {
+			withOther[unit] = map[string]string{Other: unitCases[Genitive][unit][Singular]}
+			for category, form := range categories {
+				withOther[unit][category] = form
+			}
+		}
+
+		names[gramCase] = withOther
+	}
+
+	return names
+}
+
+// RegisterLocale регистрирует язык под тегом tag (например, "de"), после чего его можно получить
+// через LookupLocale(). Повторная регистрация заменяет язык, в том числе встроенный.
+func RegisterLocale(tag string, locale Locale) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+
+	locales[tag] = locale
+}
+
+// LookupLocale возвращает язык, зарегистрированный под тегом tag. Встроенные языки: "ru", "en", "uk", "be",
+// "pl", "cs", "sk", "lt" и "lv".
+func LookupLocale(tag string) (Locale, bool) {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	locale, ok := locales[tag]
+
+	return locale, ok
+}
+
+// InLocale устанавливает язык вывода. Вывод прописью (InWords()) и Relative() доступны только
+// на русском языке, в том числе на созданном на его основе через CustomLocale(). locale = nil означает Russian.
+func (d *Durafmt) InLocale(locale Locale) *Durafmt {
+	return d.with(WithLocale(locale))
+}
+
+// lang возвращает язык вывода.
+func (d *Durafmt) lang() Locale {
+	if d.locale == nil {
+		return Russian
+	}
+
+	return d.locale
+}
+
+// russian сообщает, выводится ли результат на русском языке, в том числе на языке, созданном
+// CustomLocale() на основе Russian.
+func (d *Durafmt) russian() bool {
+	return isRussian(d.lang())
+}
+
+// isRussian сообщает, является ли locale русским языком или созданным на его основе через CustomLocale().
+func isRussian(locale Locale) bool {
+	if custom, ok := locale.(*customLocale); ok {
+		return isRussian(custom.Locale)
+	}
+
+	return locale == Locale(Russian)
+}
+
+// UnitNames хранит названия единиц времени: единица времени → категория множественного числа → название.
+type UnitNames map[string]map[string]string
+
+// customLocale — язык, в котором часть названий единиц времени заменена.
+type customLocale struct {
+	Locale
+
+	names map[string]UnitNames
+}
+
+// UnitName возвращает заменённое название единицы времени, а если единица не заменялась — название из
+// исходного языка. Пустой падеж означает именительный. Падежи, которых нет в names, встречаются только
+// у несклоняемых языков, и для них используются названия в именительном падеже.
+func (c *customLocale) UnitName(grammaticalCase, unit, category string) string {
+	if forms, ok := c.names[grammaticalCase][unit]; ok {
+		return forms[category]
+	}
+
+	if forms, ok := c.names[Nominative][unit]; ok {
+		return forms[category]
+	}
+
+	return c.Locale.UnitName(grammaticalCase, unit, category)
+}
+
+// localeGender возвращает род единицы времени unit в языке locale: заданный через Gender в CustomLocale(),
+// а если он не задан — род русского названия.
+func localeGender(locale Locale, unit string) string {
+	custom, ok := locale.(*customLocale)
+	if !ok {
+		return unitGenders[unit]
+	}
+
+	if forms, ok := custom.names[Nominative][unit]; ok {
+		if gender := forms[Gender]; gender != "" {
+			return gender
+		}
+
+		return unitGenders[unit]
+	}
+
+	return localeGender(custom.Locale, unit)
+}
+
+// CustomLocale возвращает язык base (nil означает Russian), в котором названия единиц времени заменены
+// названиями из names (падеж → названия). Например, "сек." вместо "секунда" или название бренда.
+// Если base склоняет названия единиц времени (русский, украинский, белорусский), каждая заменяемая единица
+// времени должна быть задана во всех шести падежах, иначе возвращается ошибка: подставлять именительный
+// падеж или форму исходного языка вместо недостающей значило бы выводить "одного сутки" или смешивать слова.
+// Для несклоняемых языков достаточно именительного падежа. Для каждой заданной единицы времени должны быть
+// указаны все формы, которые требуются правилами множественного числа base, включая форму для дробных чисел.
+// Род нового названия, с которым согласуются числа прописью, "остался" и "за последний", задаётся ключом
+// Gender в именительном падеже; по умолчанию используется род заменяемого русского названия.
+// Полученный язык можно передать в InLocale() или WithLocale().
+func CustomLocale(base Locale, names map[string]UnitNames) (Locale, error) {
+	if base == nil {
+		base = Russian
+	}
+
+	required := requiredCategories(base)
+	declines := declinable(base)
+
+	for gramCase, caseNames := range names {
+		if !knownCase(gramCase) {
+			return nil, fmt.Errorf("durafmt_ru: неизвестный падеж %q", gramCase)
+		}
+
+		for unit := range caseNames {
+			if unitIndex(unit) < 0 {
+				return nil, fmt.Errorf("durafmt_ru: неизвестная единица времени %q", unit)
+			}
+		}
+	}
+
+	for _, gramCase := range caseOrder {
+		for _, unit := range units {
+			forms, ok := names[gramCase][unit]
+			if !ok {
+				continue
+			}
+
+			if _, ok := names[Nominative][unit]; !ok {
+				return nil, fmt.Errorf("durafmt_ru: единица времени %q задана в падеже %q, но не задана в именительном падеже",
+					unit, gramCase)
+			}
+
+			for category, form := range forms {
+				if category == Gender {
+					if gramCase != Nominative {
+						return nil, fmt.Errorf("durafmt_ru: род единицы времени %q задан в падеже %q, а не в именительном",
+							unit, gramCase)
+					}
+
+					if !knownGender(form) {
+						return nil, fmt.Errorf("durafmt_ru: неизвестный род %q у единицы времени %q", form, unit)
+					}
+
+					continue
+				}
+
+				if !knownCategory(category) {
+					return nil, fmt.Errorf("durafmt_ru: неизвестная категория множественного числа %q у единицы времени %q",
+						category, unit)
+				}
+			}
+
+			for _, category := range required {
+				if forms[category] == "" {
+					return nil, fmt.Errorf("durafmt_ru: нет формы категории %q у единицы времени %q в падеже %q",
+						category, unit, gramCase)
+				}
+			}
+		}
+	}
+
+	if declines {
+		for unit := range names[Nominative] {
+			for _, gramCase := range caseOrder {
+				if _, ok := names[gramCase][unit]; !ok {
+					return nil, fmt.Errorf("durafmt_ru: единица времени %q не задана в падеже %q", unit, gramCase)
+				}
+			}
+		}
+	}
+
+	return &customLocale{Locale: base, names: copyNames(names)}, nil
+}
+
+// copyNames возвращает глубокую копию names, чтобы изменения исходных таблиц после проверки
+// не влияли на язык.
+func copyNames(names map[string]UnitNames) map[string]UnitNames {
+	copied := make(map[string]UnitNames, len(names))
+
+	for gramCase, caseNames := range names {
+		copied[gramCase] = make(UnitNames, len(caseNames))
+
+		for unit, forms := range caseNames {
+			copied[gramCase][unit] = make(map[string]string, len(forms))
+
+			for category, form := range forms {
+				copied[gramCase][unit][category] = form
+			}
+		}
+	}
+
+	return copied
+}
+
+// requiredCategories возвращает категории множественного числа, которые использует язык: для целых чисел
+// от 0 до 1000 (правила CLDR для больших чисел повторяются) и для дробных чисел.
+func requiredCategories(locale Locale) []string {
+	var required []string
+
+	seen := make(map[string]bool)
+
+	add := func(category string) {
+		if !seen[category] {
+			seen[category] = true
+			required = append(required, category)
+		}
+	}
+
+	for v := int64(0); v <= 1000; v++ {
+		add(locale.PluralCategory(v))
+	}
+
+	add(locale.FractionCategory())
+
+	return required
+}
+
+// declinable сообщает, склоняет ли язык locale названия единиц времени, то есть отличается ли название
+// хотя бы одной единицы времени в каком-нибудь падеже от названия в именительном.
+func declinable(locale Locale) bool {
+	for _, unit := range units {
+		nominative := locale.UnitName(Nominative, unit, Singular)
+
+		for _, gramCase := range caseOrder[1:] {
+			if locale.UnitName(gramCase, unit, Singular) != nominative {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// knownGender сообщает, известен ли род gender.
+func knownGender(gender string) bool {
+	switch gender {
+	case Masculine, Feminine, Neuter, Plural:
+		return true
+	default:
+		return false
+	}
+}
+
+// knownCase сообщает, известен ли падеж grammaticalCase.
+func knownCase(grammaticalCase string) bool {
+	for _, c := range caseOrder {
+		if c == grammaticalCase {
+			return true
+		}
+	}
+
+	return false
+}
+
+// knownCategory сообщает, известна ли категория множественного числа category.
+func knownCategory(category string) bool {
+	return categoryIndex(category) >= 0
+}
+
+// categoryIndex возвращает индекс категории множественного числа в categoryOrder или -1, если такой
+// категории нет.
+func categoryIndex(category string) int {
+	switch category {
+	case Singular:
+		return 0
+	case Some:
+		return 1
+	case Many:
+		return 2
+	case Zero:
+		return 3
+	case Two:
+		return 4
+	case Other:
+		return 5
+	default:
+		return -1
+	}
+}
+
+// caseNumber возвращает индекс падежа в caseOrder или -1, если такого падежа нет. Пустой падеж означает
+// именительный.
+func caseNumber(grammaticalCase string) int {
+	switch grammaticalCase {
+	case Nominative, "":
+		return 0
+	case Genitive:
+		return 1
+	case Dative:
+		return 2
+	case Accusative:
+		return 3
+	case Instrumental:
+		return 4
+	case Prepositional:
+		return 5
+	default:
+		return -1
+	}
+}
+
+// abbrStyleIndex возвращает индекс стиля в abbrStyles или -1, если у стиля нет сокращений.
+func abbrStyleIndex(style string) int {
+	switch style {
+	case StyleShort:
+		return 0
+	case StyleNarrow:
+		return 1
+	default:
+		return -1
+	}
+}
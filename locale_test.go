@@ -0,0 +1,434 @@
+package durufmt
+
+import (
+	"testing"
+	"time"
+)
+
+// TestInLocale тестирует вывод на встроенных языках.
+func TestInLocale(t *testing.T) {
+	timeduration := (354 * time.Hour) + (22 * time.Minute) + (3 * time.Second)
+
+	testLocales := []struct {
+		name     string
+		test     *Durafmt
+		expected string
+	}{
+		{"язык по умолчанию", Parse(timeduration).InLocale(nil), "2 недели 18 часов 22 минуты 3 секунды"},
+		{"русский", Parse(timeduration).InLocale(Russian), "2 недели 18 часов 22 минуты 3 секунды"},
+		{"английский", Parse(timeduration).InLocale(English), "2 weeks 18 hours 22 minutes 3 seconds"},
+		{"английский, единственное число", Parse(time.Hour + time.Minute).InLocale(English), "1 hour 1 minute"},
+		{"английский, 21 минута", Parse(21 * time.Minute).InLocale(English), "21 minutes"},
+		{"английский, ноль", Parse(0).InLocale(English), "0 seconds"},
+		{"английский, отрицательная продолжительность", Parse(-time.Minute).InLocale(English), "-1 minute"},
+		{"английский, StyleShort", Parse(timeduration).InLocale(English).InStyle(StyleShort), "2 wk 18 hr 22 min 3 s"},
+		{"английский, StyleNarrow", Parse(timeduration).InLocale(English).InStyle(StyleNarrow), "2w 18h 22m 3s"},
+		{
+			"английский, союз",
+			Parse(timeduration).InLocale(English).Conjunction("and", false),
+			"2 weeks 18 hours 22 minutes and 3 seconds",
+		},
+		{"английский, дробное число", Parse(90*time.Minute).InLocale(English).Decimal(Hours, 1), "1.5 hours"},
+		{"русский, дробное число", Parse(90*time.Minute).InLocale(Russian).Decimal(Hours, 1), "1,5 часа"},
+		{"английский, Decimal для целого числа", Parse(2*time.Hour).InLocale(English).Decimal(Hours, 1), "2 hours"},
+		// Падежи и вывод прописью есть только в русском языке.
+		{"английский, падеж", Parse(time.Minute).InLocale(English).InCase(Accusative), "1 minute"},
+		{"английский, прописью", Parse(21 * time.Minute).InLocale(English).InWords(true), "21 minutes"},
+	}
+
+	for _, table := range testLocales {
+		t.Run(table.name, func(t *testing.T) {
+			if result := table.test.String(); result != table.expected {
+				t.Errorf("String() = %q, ожидалось %q", result, table.expected)
+			}
+		})
+	}
+}
+
+// TestRelativeIgnoresLocale тестирует, что Relative() выводит результат на русском языке.
+func TestRelativeIgnoresLocale(t *testing.T) {
+	if result := Parse(5 * time.Minute).InLocale(English).Relative(); result != "через 5 минут" {
+		t.Errorf("Relative() = %q, ожидалось %q", result, "через 5 минут")
+	}
+}
+
+// TestRussianPluralCategory тестирует правило выбора категории множественного числа русского языка.
+func TestRussianPluralCategory(t *testing.T) {
+	testCategories := map[int64]string{
+		0: Many, 1: Singular, 2: Some, 4: Some, 5: Many, 11: Many, 12: Many, 14: Many,
+		21: Singular, 22: Some, 25: Many, 101: Singular, 111: Many, 112: Many, 122: Some, -1: Singular,
+	}
+
+	for v, expected := range testCategories {
+		if result := Russian.PluralCategory(v); result != expected {
+			t.Errorf("PluralCategory(%d) = %q, ожидалось %q", v, result, expected)
+		}
+	}
+}
+
+// TestRegisterLocale тестирует регистрацию нового языка.
+func TestRegisterLocale(t *testing.T) {
+	for _, tag := range []string{"ru", "en"} {
+		if _, ok := LookupLocale(tag); !ok {
+			t.Errorf("LookupLocale(%q): встроенный язык не найден", tag)
+		}
+	}
+
+	if _, ok := LookupLocale("eo"); ok {
+		t.Fatalf("LookupLocale(%q): найден незарегистрированный язык", "eo")
+	}
+
+	esperanto := &LocaleTable{
+		Plural: English.Plural,
+		Names: map[string]map[string]map[string]string{
+			Nominative: {
+				Hours:   {Singular: "horo", Other: "horoj"},
+				Minutes: {Singular: "minuto", Other: "minutoj"},
+			},
+		},
+		ElementSep: ", ",
+	}
+	RegisterLocale("eo", esperanto)
+
+	defer func() {
+		localesMu.Lock()
+		delete(locales, "eo")
+		localesMu.Unlock()
+	}()
+
+	locale, ok := LookupLocale("eo")
+	if !ok {
+		t.Fatalf("LookupLocale(%q): зарегистрированный язык не найден", "eo")
+	}
+
+	expected := "2 horoj, 1 minuto"
+	if result := NewFormatter(WithLocale(locale)).Format(2*time.Hour + time.Minute); result != expected {
+		t.Errorf("Format() = %q, ожидалось %q", result, expected)
+	}
+}
+
+// TestLocaleTableIndex проверяет, что названия и сокращения LocaleTable находятся и по массивам, и по самим
+// таблицам для падежей и стилей, которых нет в массивах.
+func TestLocaleTableIndex(t *testing.T) {
+	for idx, gramCase := range caseOrder {
+		if result := caseNumber(gramCase); result != idx {
+			t.Errorf("caseNumber(%q) = %d, ожидалось %d", gramCase, result, idx)
+		}
+	}
+
+	for idx, category := range categoryOrder {
+		if result := categoryIndex(category); result != idx {
+			t.Errorf("categoryIndex(%q) = %d, ожидалось %d", category, result, idx)
+		}
+	}
+
+	latin := &LocaleTable{
+		Plural: English.Plural,
+		Names: map[string]map[string]map[string]string{
+			Nominative: {Days: {Singular: "dies", Other: "dies"}},
+			"vocative": {Days: {Singular: "dies!"}},
+		},
+		Abbrs: map[string]map[string]string{
+			StyleShort: {Days: "d."},
+			"tiny":     {Days: "d"},
+		},
+	}
+
+	testNames := []struct {
+		gramCase string
+		unit     string
+		category string
+		expected string
+	}{
+		{Nominative, Days, Singular, "dies"},
+		{"", Days, Other, "dies"},
+		{Genitive, Days, Singular, "dies"},
+		{"vocative", Days, Singular, "dies!"},
+		{Nominative, Hours, Singular, ""},
+		{Nominative, Days, "dual", ""},
+	}
+
+	for _, table := range testNames {
+		if result := latin.UnitName(table.gramCase, table.unit, table.category); result != table.expected {
+			t.Errorf("UnitName(%q, %q, %q) = %q, ожидалось %q",
+				table.gramCase, table.unit, table.category, result, table.expected)
+		}
+	}
+
+	testAbbrs := []struct {
+		style    string
+		expected string
+	}{
+		{StyleShort, "d."},
+		{StyleNarrow, ""},
+		{StyleFull, ""},
+		{"tiny", "d"},
+	}
+
+	for _, table := range testAbbrs {
+		if result := latin.UnitAbbr(table.style, Days); result != table.expected {
+			t.Errorf("UnitAbbr(%q, %q) = %q, ожидалось %q", table.style, Days, result, table.expected)
+		}
+	}
+}
+
+// TestCustomLocale тестирует замену названий единиц времени.
+func TestCustomLocale(t *testing.T) {
+	compact, err := CustomLocale(nil, inAllCases(UnitNames{
+		Seconds: {Singular: "сек.", Some: "сек.", Many: "сек.", Other: "сек."},
+		Minutes: {Singular: "мин.", Some: "мин.", Many: "мин.", Other: "мин."},
+	}))
+	if err != nil {
+		t.Fatalf("CustomLocale(): %v", err)
+	}
+
+	crew, err := CustomLocale(English, map[string]UnitNames{
+		Nominative: {
+			Days: {Singular: "shift", Other: "shifts"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CustomLocale(): %v", err)
+	}
+
+	weeks, err := CustomLocale(Russian, map[string]UnitNames{
+		Nominative: {
+			Weeks: {Singular: "седмица", Some: "седмицы", Many: "седмиц", Other: "седмицы"},
+		},
+		Genitive: {
+			Weeks: {Singular: "седмицы", Some: "седмиц", Many: "седмиц", Other: "седмицы"},
+		},
+		Dative: {
+			Weeks: {Singular: "седмице", Some: "седмицам", Many: "седмицам", Other: "седмицы"},
+		},
+		Accusative: {
+			Weeks: {Singular: "седмицу", Some: "седмицы", Many: "седмиц", Other: "седмицы"},
+		},
+		Instrumental: {
+			Weeks: {Singular: "седмицей", Some: "седмицами", Many: "седмицами", Other: "седмицы"},
+		},
+		Prepositional: {
+			Weeks: {Singular: "седмице", Some: "седмицах", Many: "седмицах", Other: "седмицы"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CustomLocale(): %v", err)
+	}
+
+	testCustom := []struct {
+		name     string
+		test     *Durafmt
+		expected string
+	}{
+		{"сокращения", Parse(time.Hour + 2*time.Minute + 5*time.Second).InLocale(compact), "1 час 2 мин. 5 сек."},
+		{"сокращения, дробное число", Parse(90*time.Second).InLocale(compact).Decimal(Minutes, 1), "1,5 мин."},
+		{"английский", Parse(50 * time.Hour).InLocale(crew), "2 shifts 2 hours"},
+		{"русский", Parse(7 * 24 * time.Hour).InLocale(weeks), "1 седмица"},
+		{"русский, винительный падеж", Parse(7 * 24 * time.Hour).InLocale(weeks).InCase(Accusative), "1 седмицу"},
+		{"русский, родительный падеж", Parse(14 * 24 * time.Hour).InLocale(weeks).InCase(Genitive), "2 седмиц"},
+		{"незаменённая единица", Parse(24 * time.Hour).InLocale(weeks).InCase(Genitive), "1 дня"},
+	}
+
+	for _, table := range testCustom {
+		t.Run(table.name, func(t *testing.T) {
+			if result := table.test.String(); result != table.expected {
+				t.Errorf("String() = %q, ожидалось %q", result, table.expected)
+			}
+		})
+	}
+}
+
+// inAllCases возвращает таблицу для CustomLocale(), в которой названия names одинаковы во всех падежах.
+func inAllCases(names UnitNames) map[string]UnitNames {
+	cases := make(map[string]UnitNames, len(caseOrder))
+
+	for _, gramCase := range caseOrder {
+		cases[gramCase] = names
+	}
+
+	return cases
+}
+
+// TestCustomLocaleCopiesNames тестирует, что изменение таблиц после CustomLocale() не влияет на язык.
+func TestCustomLocaleCopiesNames(t *testing.T) {
+	names := inAllCases(UnitNames{
+		Seconds: {Singular: "сек.", Some: "сек.", Many: "сек.", Other: "сек."},
+	})
+
+	locale, err := CustomLocale(nil, names)
+	if err != nil {
+		t.Fatalf("CustomLocale(): %v", err)
+	}
+
+	names[Nominative][Seconds][Many] = ""
+	names[Genitive] = UnitNames{Seconds: {}}
+
+	if result := Parse(5 * time.Second).InLocale(locale).String(); result != "5 сек." {
+		t.Errorf("Parse(5 * time.Second).InLocale(locale).String() = %q, ожидалось %q", result, "5 сек.")
+	}
+
+	if result := Parse(5 * time.Second).InLocale(locale).InCase(Genitive).String(); result != "5 сек." {
+		t.Errorf("Parse(5 * time.Second).InLocale(locale).InCase(Genitive).String() = %q, ожидалось %q",
+			result, "5 сек.")
+	}
+}
+
+// TestCustomLocaleRussianFeatures тестирует, что язык, созданный CustomLocale() на основе Russian,
+// поддерживает возможности, доступные только на русском языке.
+func TestCustomLocaleRussianFeatures(t *testing.T) {
+	day := 24 * time.Hour
+
+	weeks, err := CustomLocale(nil, map[string]UnitNames{
+		Nominative: {
+			Weeks: {Singular: "седмица", Some: "седмицы", Many: "седмиц", Other: "седмицы"},
+		},
+		Genitive: {
+			Weeks: {Singular: "седмицы", Some: "седмиц", Many: "седмиц", Other: "седмицы"},
+		},
+		Dative: {
+			Weeks: {Singular: "седмице", Some: "седмицам", Many: "седмицам", Other: "седмицы"},
+		},
+		Accusative: {
+			Weeks: {Singular: "седмицу", Some: "седмицы", Many: "седмиц", Other: "седмицы"},
+		},
+		Instrumental: {
+			Weeks: {Singular: "седмицей", Some: "седмицами", Many: "седмицами", Other: "седмицы"},
+		},
+		Prepositional: {
+			Weeks: {Singular: "седмице", Some: "седмицах", Many: "седмицах", Other: "седмицы"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CustomLocale(): %v", err)
+	}
+
+	testFeatures := []struct {
+		name     string
+		test     *Durafmt
+		expected string
+	}{
+		{"прописью, незаменённая единица", Parse(time.Minute).InLocale(weeks).InWords(true), "одна минута"},
+		{"прописью", Parse(7 * day).InLocale(weeks).InWords(true), "одна седмица"},
+		{"Predicate", Parse(time.Minute).InLocale(weeks).Predicate("осталось"), "осталась 1 минута"},
+		{
+			"Period, незаменённая единица",
+			Parse(time.Minute).InLocale(weeks).Period("за", "последний"),
+			"за последнюю минуту",
+		},
+		{"Period", Parse(7*day).InLocale(weeks).Period("за", "последний"), "за последнюю седмицу"},
+		{"DayUnit", Parse(day).InLocale(weeks).DayUnit(DayUnitSutki), "1 сутки"},
+		{"WorkDay", Parse(8 * time.Hour).InLocale(weeks).WorkDay(8 * time.Hour), "1 рабочий день"},
+	}
+
+	for _, table := range testFeatures {
+		t.Run(table.name, func(t *testing.T) {
+			if result := table.test.String(); result != table.expected {
+				t.Errorf("String() = %q, ожидалось %q", result, table.expected)
+			}
+		})
+	}
+
+	if result := Parse(7 * day).InLocale(weeks).Relative(); result != "через 1 седмицу" {
+		t.Errorf("Relative() = %q, ожидалось %q", result, "через 1 седмицу")
+	}
+
+	f := NewFormatter(WithLocale(weeks), WithRangeStyle(RangeFromTo))
+	if result := f.FormatRange(14*day, 21*day); result != "от 2 до 3 седмиц" {
+		t.Errorf("FormatRange() = %q, ожидалось %q", result, "от 2 до 3 седмиц")
+	}
+
+	if result := f.Compare(14*day, 7*day); result != "на 1 седмицу дольше" {
+		t.Errorf("Compare() = %q, ожидалось %q", result, "на 1 седмицу дольше")
+	}
+}
+
+// TestCustomLocaleCases тестирует названия в косвенных падежах и род, заданный через Gender.
+func TestCustomLocaleCases(t *testing.T) {
+	day := 24 * time.Hour
+
+	sutki, err := CustomLocale(nil, map[string]UnitNames{
+		Nominative:    {Days: {Singular: "сутки", Some: "суток", Many: "суток", Other: "суток", Gender: Plural}},
+		Genitive:      {Days: {Singular: "суток", Some: "суток", Many: "суток", Other: "суток"}},
+		Dative:        {Days: {Singular: "суткам", Some: "суткам", Many: "суткам", Other: "суток"}},
+		Accusative:    {Days: {Singular: "сутки", Some: "суток", Many: "суток", Other: "суток"}},
+		Instrumental:  {Days: {Singular: "сутками", Some: "сутками", Many: "сутками", Other: "суток"}},
+		Prepositional: {Days: {Singular: "сутках", Some: "сутках", Many: "сутках", Other: "суток"}},
+	})
+	if err != nil {
+		t.Fatalf("CustomLocale(): %v", err)
+	}
+
+	testCases := []struct {
+		input    time.Duration
+		gramCase string
+		expected string
+	}{
+		{day, Nominative, "одни сутки"},
+		{day, Genitive, "одних суток"},
+		{day, Dative, "одним суткам"},
+		{2 * day, Instrumental, "двумя сутками"},
+		{5 * day, Prepositional, "пяти сутках"},
+	}
+
+	for _, table := range testCases {
+		result := Parse(table.input).InLocale(sutki).InCase(table.gramCase).InWords(true).String()
+		if result != table.expected {
+			t.Errorf("Parse(%v).InCase(%q).InWords(true) = %q, ожидалось %q",
+				table.input, table.gramCase, result, table.expected)
+		}
+	}
+
+	if result := Parse(day).InLocale(sutki).Period("за", "последний").String(); result != "за последние сутки" {
+		t.Errorf("Period() = %q, ожидалось %q", result, "за последние сутки")
+	}
+}
+
+// TestCustomLocaleErrors тестирует проверку заменяемых названий единиц времени.
+func TestCustomLocaleErrors(t *testing.T) {
+	testErrors := []struct {
+		base     Locale
+		names    map[string]UnitNames
+		expected string
+	}{
+		{nil, map[string]UnitNames{Nominative: {Seconds: {Singular: "сек.", Some: "сек.", Many: "сек."}}},
+			`durafmt_ru: нет формы категории "other" у единицы времени "seconds" в падеже "nominative"`},
+		{English, map[string]UnitNames{Nominative: {Days: {Singular: "shift"}}},
+			`durafmt_ru: нет формы категории "other" у единицы времени "days" в падеже "nominative"`},
+		{Latvian, map[string]UnitNames{Nominative: {Days: {Singular: "diena", Other: "dienas"}}},
+			`durafmt_ru: нет формы категории "zero" у единицы времени "days" в падеже "nominative"`},
+		{nil, map[string]UnitNames{Nominative: {"fortnights": {Singular: "две недели"}}},
+			`durafmt_ru: неизвестная единица времени "fortnights"`},
+		{nil, map[string]UnitNames{"vocative": {Days: {Singular: "дне"}}},
+			`durafmt_ru: неизвестный падеж "vocative"`},
+		{nil, map[string]UnitNames{Nominative: {Days: {
+			Singular: "сутки", Some: "суток", Many: "суток", Other: "суток", "dual": "суток",
+		}}},
+			`durafmt_ru: неизвестная категория множественного числа "dual" у единицы времени "days"`},
+		{nil, map[string]UnitNames{Genitive: {Days: {Singular: "суток", Some: "суток", Many: "суток", Other: "суток"}}},
+			`durafmt_ru: единица времени "days" задана в падеже "genitive", но не задана в именительном падеже`},
+		{nil, map[string]UnitNames{Nominative: {Days: {Singular: "сутки", Some: "суток", Many: "суток", Other: "суток"}}},
+			`durafmt_ru: единица времени "days" не задана в падеже "genitive"`},
+		{Ukrainian, map[string]UnitNames{Nominative: {Days: {Singular: "доба", Some: "доби", Many: "діб", Other: "доби"}}},
+			`durafmt_ru: единица времени "days" не задана в падеже "genitive"`},
+		{English, map[string]UnitNames{Nominative: {Days: {Singular: "shift", Other: "shifts", Gender: "common"}}},
+			`durafmt_ru: неизвестный род "common" у единицы времени "days"`},
+		{English, map[string]UnitNames{
+			Nominative: {Days: {Singular: "shift", Other: "shifts"}},
+			Genitive:   {Days: {Singular: "shift", Other: "shifts", Gender: Feminine}},
+		},
+			`durafmt_ru: род единицы времени "days" задан в падеже "genitive", а не в именительном`},
+	}
+
+	for _, table := range testErrors {
+		_, err := CustomLocale(table.base, table.names)
+		if err == nil {
+			t.Errorf("CustomLocale(): ожидалась ошибка %q", table.expected)
+
+			continue
+		}
+
+		if err.Error() != table.expected {
+			t.Errorf("CustomLocale(): ошибка %q, ожидалось %q", err, table.expected)
+		}
+	}
+}
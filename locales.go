@@ -0,0 +1,568 @@
+package durufmt
+
+// caseOrder задаёт порядок падежей в таблицах склонений для declensionNames.
+var caseOrder = [...]string{Nominative, Genitive, Dative, Accusative, Instrumental, Prepositional}
+
+// declension хранит формы единственного и множественного числа существительного во всех падежах в порядке
+// caseOrder: именительный ед., именительный мн., родительный ед., родительный мн. и так далее.
+type declension [2 * len(caseOrder)]string
+
+// declensionNames собирает таблицу названий для языков, в которых, как в украинском и белорусском, после
+// 2, 3 и 4 в именительном и винительном падежах стоит множественное число того же падежа ("2 хвилини"),
+// после 5–20 — родительный падеж множественного числа ("5 хвилин"), а после дробных чисел — родительный
+// падеж единственного числа ("1,5 хвилини"). В остальных падежах числительное согласуется с существительным.
+func declensionNames(forms map[string]declension) map[string]map[string]map[string]string {
+	names := make(map[string]map[string]map[string]string, len(caseOrder))
+
+	for idx, gramCase := range caseOrder {
+		names[gramCase] = make(map[string]map[string]string, len(forms))
+
+		for unit, f := range forms {
+			singular, plural := f[2*idx], f[2*idx+1]
+			many := plural
+
+			if gramCase == Nominative || gramCase == Accusative {
+				many = f[3]
+			}
+
+			names[gramCase][unit] = map[string]string{Singular: singular, Some: plural, Many: many, Other: f[2]}
+		}
+	}
+
+	return names
+}
+
+var (
+	// Ukrainian — украинский язык: "2 тижні 18 годин 22 хвилини 3 секунди".
+	Ukrainian = &LocaleTable{
+		Plural: pluralCategory,
+		Names: declensionNames(map[string]declension{
+			Centuries: {
+				"століття", "століття", "століття", "століть", "століттю", "століттям", "століття", "століття",
+				"століттям", "століттями", "столітті", "століттях",
+			},
+			Decades: {
+				"десятиліття", "десятиліття", "десятиліття", "десятиліть", "десятиліттю", "десятиліттям",
+				"десятиліття", "десятиліття", "десятиліттям", "десятиліттями", "десятилітті", "десятиліттях",
+			},
+			Years: {
+				"рік", "роки", "року", "років", "року", "рокам", "рік", "роки", "роком", "роками", "році", "роках",
+			},
+			Quarters: {
+				"квартал", "квартали", "кварталу", "кварталів", "кварталу", "кварталам", "квартал", "квартали",
+				"кварталом", "кварталами", "кварталі", "кварталах",
+			},
+			Months: {
+				"місяць", "місяці", "місяця", "місяців", "місяцю", "місяцям", "місяць", "місяці",
+				"місяцем", "місяцями", "місяці", "місяцях",
+			},
+			Weeks: {
+				"тиждень", "тижні", "тижня", "тижнів", "тижню", "тижням", "тиждень", "тижні", "тижнем", "тижнями",
+				"тижні", "тижнях",
+			},
+			Days: {
+				"день", "дні", "дня", "днів", "дню", "дням", "день", "дні", "днем", "днями", "дні", "днях",
+			},
+			Hours: {
+				"година", "години", "години", "годин", "годині", "годинам", "годину", "години",
+				"годиною", "годинами", "годині", "годинах",
+			},
+			Minutes: {
+				"хвилина", "хвилини", "хвилини", "хвилин", "хвилині", "хвилинам", "хвилину", "хвилини",
+				"хвилиною", "хвилинами", "хвилині", "хвилинах",
+			},
+			Seconds: {
+				"секунда", "секунди", "секунди", "секунд", "секунді", "секундам", "секунду", "секунди",
+				"секундою", "секундами", "секунді", "секундах",
+			},
+			Milliseconds: {
+				"мілісекунда", "мілісекунди", "мілісекунди", "мілісекунд", "мілісекунді", "мілісекундам",
+				"мілісекунду", "мілісекунди", "мілісекундою", "мілісекундами", "мілісекунді", "мілісекундах",
+			},
+			Microseconds: {
+				"мікросекунда", "мікросекунди", "мікросекунди", "мікросекунд", "мікросекунді", "мікросекундам",
+				"мікросекунду", "мікросекунди", "мікросекундою", "мікросекундами", "мікросекунді", "мікросекундах",
+			},
+			Nanoseconds: {
+				"наносекунда", "наносекунди", "наносекунди", "наносекунд", "наносекунді", "наносекундам",
+				"наносекунду", "наносекунди", "наносекундою", "наносекундами", "наносекунді", "наносекундах",
+			},
+		}),
+		Abbrs: map[string]map[string]string{
+			StyleShort: {
+				Centuries:    "ст",
+				Decades:      "дес",
+				Years:        "р",
+				Quarters:     "кв",
+				Months:       "міс",
+				Weeks:        "тиж",
+				Days:         "д",
+				Hours:        "год",
+				Minutes:      "хв",
+				Seconds:      "с",
+				Milliseconds: "мс",
+				Microseconds: "мкс",
+				Nanoseconds:  "нс",
+			},
+			StyleNarrow: {
+				Centuries:    "ст",
+				Decades:      "дес",
+				Years:        "р",
+				Quarters:     "кв",
+				Months:       "міс",
+				Weeks:        "т",
+				Days:         "д",
+				Hours:        "г",
+				Minutes:      "хв",
+				Seconds:      "с",
+				Milliseconds: "мс",
+				Microseconds: "мкс",
+				Nanoseconds:  "нс",
+			},
+		},
+		DecimalPoint: ",",
+	}
+
+	// Belarusian — белорусский язык: "2 тыдні 18 гадзін 22 хвіліны 3 секунды".
+	Belarusian = &LocaleTable{
+		Plural: pluralCategory,
+		Names: declensionNames(map[string]declension{
+			Centuries: {
+				"стагоддзе", "стагоддзі", "стагоддзя", "стагоддзяў", "стагоддзю", "стагоддзям",
+				"стагоддзе", "стагоддзі", "стагоддзем", "стагоддзямі", "стагоддзі", "стагоддзях",
+			},
+			Decades: {
+				"дзесяцігоддзе", "дзесяцігоддзі", "дзесяцігоддзя", "дзесяцігоддзяў",
+				"дзесяцігоддзю", "дзесяцігоддзям", "дзесяцігоддзе", "дзесяцігоддзі",
+				"дзесяцігоддзем", "дзесяцігоддзямі", "дзесяцігоддзі", "дзесяцігоддзях",
+			},
+			Years: {
+				"год", "гады", "года", "гадоў", "году", "гадам", "год", "гады", "годам", "гадамі", "годзе", "гадах",
+			},
+			Quarters: {
+				"квартал", "кварталы", "квартала", "кварталаў", "кварталу", "кварталам", "квартал", "кварталы",
+				"кварталам", "кварталамі", "квартале", "кварталах",
+			},
+			Months: {
+				"месяц", "месяцы", "месяца", "месяцаў", "месяцу", "месяцам", "месяц", "месяцы",
+				"месяцам", "месяцамі", "месяцы", "месяцах",
+			},
+			Weeks: {
+				"тыдзень", "тыдні", "тыдня", "тыдняў", "тыдню", "тыдням", "тыдзень", "тыдні", "тыднем", "тыднямі",
+				"тыдні", "тыднях",
+			},
+			Days: {
+				"дзень", "дні", "дня", "дзён", "дню", "дням", "дзень", "дні", "днём", "днямі", "дні", "днях",
+			},
+			Hours: {
+				"гадзіна", "гадзіны", "гадзіны", "гадзін", "гадзіне", "гадзінам", "гадзіну", "гадзіны",
+				"гадзінай", "гадзінамі", "гадзіне", "гадзінах",
+			},
+			Minutes: {
+				"хвіліна", "хвіліны", "хвіліны", "хвілін", "хвіліне", "хвілінам", "хвіліну", "хвіліны",
+				"хвілінай", "хвілінамі", "хвіліне", "хвілінах",
+			},
+			Seconds: {
+				"секунда", "секунды", "секунды", "секунд", "секундзе", "секундам", "секунду", "секунды",
+				"секундай", "секундамі", "секундзе", "секундах",
+			},
+			Milliseconds: {
+				"мілісекунда", "мілісекунды", "мілісекунды", "мілісекунд", "мілісекундзе", "мілісекундам",
+				"мілісекунду", "мілісекунды", "мілісекундай", "мілісекундамі", "мілісекундзе", "мілісекундах",
+			},
+			Microseconds: {
+				"мікрасекунда", "мікрасекунды", "мікрасекунды", "мікрасекунд", "мікрасекундзе", "мікрасекундам",
+				"мікрасекунду", "мікрасекунды", "мікрасекундай", "мікрасекундамі", "мікрасекундзе", "мікрасекундах",
+			},
+			Nanoseconds: {
+				"нанасекунда", "нанасекунды", "нанасекунды", "нанасекунд", "нанасекундзе", "нанасекундам",
+				"нанасекунду", "нанасекунды", "нанасекундай", "нанасекундамі", "нанасекундзе", "нанасекундах",
+			},
+		}),
+		Abbrs: map[string]map[string]string{
+			StyleShort: {
+				Centuries:    "ст",
+				Decades:      "дзес",
+				Years:        "г",
+				Quarters:     "кв",
+				Months:       "мес",
+				Weeks:        "тыдз",
+				Days:         "д",
+				Hours:        "гадз",
+				Minutes:      "хв",
+				Seconds:      "с",
+				Milliseconds: "мс",
+				Microseconds: "мкс",
+				Nanoseconds:  "нс",
+			},
+			StyleNarrow: {
+				Centuries:    "ст",
+				Decades:      "дзес",
+				Years:        "г",
+				Quarters:     "кв",
+				Months:       "мес",
+				Weeks:        "т",
+				Days:         "д",
+				Hours:        "гадз",
+				Minutes:      "хв",
+				Seconds:      "с",
+				Milliseconds: "мс",
+				Microseconds: "мкс",
+				Nanoseconds:  "нс",
+			},
+		},
+		DecimalPoint: ",",
+	}
+)
+
+// Правила выбора категории множественного числа по CLDR для целых чисел.
+
+// pluralPolish: 1 — Singular, 2–4, 22–24... — Few, остальные — Many.
+func pluralPolish(v int64) string {
+	if v < 0 {
+		v = -v
+	}
+
+	switch {
+	case v == 1:
+		return Singular
+	case v%10 >= 2 && v%10 <= 4 && (v%100 < 12 || v%100 > 14):
+		return Few
+	default:
+		return Many
+	}
+}
+
+// pluralCzech подходит для чешского и словацкого: 1 — Singular, 2–4 — Few, остальные — Other.
+// Many в этих языках используется для дробных чисел.
+func pluralCzech(v int64) string {
+	if v < 0 {
+		v = -v
+	}
+
+	switch {
+	case v == 1:
+		return Singular
+	case v >= 2 && v <= 4:
+		return Few
+	default:
+		return Other
+	}
+}
+
+// pluralLithuanian: 1, 21, 31... — Singular, 2–9, 22–29... — Few, 0, 10–20, 30... — Other.
+// Many в литовском используется для дробных чисел.
+func pluralLithuanian(v int64) string {
+	if v < 0 {
+		v = -v
+	}
+
+	switch {
+	case v%100 >= 11 && v%100 <= 19:
+		return Other
+	case v%10 == 1:
+		return Singular
+	case v%10 >= 2:
+		return Few
+	default:
+		return Other
+	}
+}
+
+// pluralLatvian: 0, 10–20, 30... — Zero, 1, 21, 31... (но не 11) — Singular, остальные — Other.
+func pluralLatvian(v int64) string {
+	if v < 0 {
+		v = -v
+	}
+
+	switch {
+	case v%10 == 0 || v%100 >= 11 && v%100 <= 19:
+		return Zero
+	case v%10 == 1:
+		return Singular
+	default:
+		return Other
+	}
+}
+
+// Для польского, чешского, словацкого, литовского и латышского языков названия единиц времени заданы
+// только в именительном падеже.
+var (
+	// Polish — польский язык: "2 tygodnie 18 godzin 22 minuty 3 sekundy".
+	Polish = &LocaleTable{
+		Plural: pluralPolish,
+		Names: map[string]map[string]map[string]string{
+			Nominative: {
+				Centuries:    {Singular: "wiek", Few: "wieki", Many: "wieków", Other: "wieku"},
+				Decades:      {Singular: "dekada", Few: "dekady", Many: "dekad", Other: "dekady"},
+				Years:        {Singular: "rok", Few: "lata", Many: "lat", Other: "roku"},
+				Quarters:     {Singular: "kwartał", Few: "kwartały", Many: "kwartałów", Other: "kwartału"},
+				Months:       {Singular: "miesiąc", Few: "miesiące", Many: "miesięcy", Other: "miesiąca"},
+				Weeks:        {Singular: "tydzień", Few: "tygodnie", Many: "tygodni", Other: "tygodnia"},
+				Days:         {Singular: "dzień", Few: "dni", Many: "dni", Other: "dnia"},
+				Hours:        {Singular: "godzina", Few: "godziny", Many: "godzin", Other: "godziny"},
+				Minutes:      {Singular: "minuta", Few: "minuty", Many: "minut", Other: "minuty"},
+				Seconds:      {Singular: "sekunda", Few: "sekundy", Many: "sekund", Other: "sekundy"},
+				Milliseconds: {Singular: "milisekunda", Few: "milisekundy", Many: "milisekund", Other: "milisekundy"},
+				Microseconds: {Singular: "mikrosekunda", Few: "mikrosekundy", Many: "mikrosekund", Other: "mikrosekundy"},
+				Nanoseconds:  {Singular: "nanosekunda", Few: "nanosekundy", Many: "nanosekund", Other: "nanosekundy"},
+			},
+		},
+		Abbrs: map[string]map[string]string{
+			StyleShort: {
+				Centuries:    "w",
+				Decades:      "dek",
+				Years:        "r",
+				Quarters:     "kw",
+				Months:       "mies",
+				Weeks:        "tydz",
+				Days:         "d",
+				Hours:        "godz",
+				Minutes:      "min",
+				Seconds:      "s",
+				Milliseconds: "ms",
+				Microseconds: "µs",
+				Nanoseconds:  "ns",
+			},
+			StyleNarrow: {
+				Centuries:    "w",
+				Decades:      "dek",
+				Years:        "r",
+				Quarters:     "kw",
+				Months:       "mies",
+				Weeks:        "tydz",
+				Days:         "d",
+				Hours:        "g",
+				Minutes:      "m",
+				Seconds:      "s",
+				Milliseconds: "ms",
+				Microseconds: "µs",
+				Nanoseconds:  "ns",
+			},
+		},
+		DecimalPoint: ",",
+	}
+
+	// Czech — чешский язык: "2 týdny 18 hodin 22 minuty 3 sekundy".
+	Czech = &LocaleTable{
+		Plural: pluralCzech,
+		Names: map[string]map[string]map[string]string{
+			Nominative: {
+				Centuries:    {Singular: "století", Few: "století", Other: "století", Many: "století"},
+				Decades:      {Singular: "desetiletí", Few: "desetiletí", Other: "desetiletí", Many: "desetiletí"},
+				Years:        {Singular: "rok", Few: "roky", Other: "let", Many: "roku"},
+				Quarters:     {Singular: "čtvrtletí", Few: "čtvrtletí", Other: "čtvrtletí", Many: "čtvrtletí"},
+				Months:       {Singular: "měsíc", Few: "měsíce", Other: "měsíců", Many: "měsíce"},
+				Weeks:        {Singular: "týden", Few: "týdny", Other: "týdnů", Many: "týdne"},
+				Days:         {Singular: "den", Few: "dny", Other: "dní", Many: "dne"},
+				Hours:        {Singular: "hodina", Few: "hodiny", Other: "hodin", Many: "hodiny"},
+				Minutes:      {Singular: "minuta", Few: "minuty", Other: "minut", Many: "minuty"},
+				Seconds:      {Singular: "sekunda", Few: "sekundy", Other: "sekund", Many: "sekundy"},
+				Milliseconds: {Singular: "milisekunda", Few: "milisekundy", Other: "milisekund", Many: "milisekundy"},
+				Microseconds: {Singular: "mikrosekunda", Few: "mikrosekundy", Other: "mikrosekund", Many: "mikrosekundy"},
+				Nanoseconds:  {Singular: "nanosekunda", Few: "nanosekundy", Other: "nanosekund", Many: "nanosekundy"},
+			},
+		},
+		Abbrs: map[string]map[string]string{
+			StyleShort: {
+				Centuries:    "stol",
+				Decades:      "desetil",
+				Years:        "r",
+				Quarters:     "čtvrtl",
+				Months:       "měs",
+				Weeks:        "týd",
+				Days:         "d",
+				Hours:        "h",
+				Minutes:      "min",
+				Seconds:      "s",
+				Milliseconds: "ms",
+				Microseconds: "µs",
+				Nanoseconds:  "ns",
+			},
+			StyleNarrow: {
+				Centuries:    "stol",
+				Decades:      "desetil",
+				Years:        "r",
+				Quarters:     "čtvrtl",
+				Months:       "měs",
+				Weeks:        "t",
+				Days:         "d",
+				Hours:        "h",
+				Minutes:      "m",
+				Seconds:      "s",
+				Milliseconds: "ms",
+				Microseconds: "µs",
+				Nanoseconds:  "ns",
+			},
+		},
+		Fraction:     Many,
+		DecimalPoint: ",",
+	}
+
+	// Slovak — словацкий язык: "2 týždne 18 hodín 22 minúty 3 sekundy".
+	Slovak = &LocaleTable{
+		Plural: pluralCzech,
+		Names: map[string]map[string]map[string]string{
+			Nominative: {
+				Centuries:    {Singular: "storočie", Few: "storočia", Other: "storočí", Many: "storočia"},
+				Decades:      {Singular: "desaťročie", Few: "desaťročia", Other: "desaťročí", Many: "desaťročia"},
+				Years:        {Singular: "rok", Few: "roky", Other: "rokov", Many: "roka"},
+				Quarters:     {Singular: "štvrťrok", Few: "štvrťroky", Other: "štvrťrokov", Many: "štvrťroka"},
+				Months:       {Singular: "mesiac", Few: "mesiace", Other: "mesiacov", Many: "mesiaca"},
+				Weeks:        {Singular: "týždeň", Few: "týždne", Other: "týždňov", Many: "týždňa"},
+				Days:         {Singular: "deň", Few: "dni", Other: "dní", Many: "dňa"},
+				Hours:        {Singular: "hodina", Few: "hodiny", Other: "hodín", Many: "hodiny"},
+				Minutes:      {Singular: "minúta", Few: "minúty", Other: "minút", Many: "minúty"},
+				Seconds:      {Singular: "sekunda", Few: "sekundy", Other: "sekúnd", Many: "sekundy"},
+				Milliseconds: {Singular: "milisekunda", Few: "milisekundy", Other: "milisekúnd", Many: "milisekundy"},
+				Microseconds: {Singular: "mikrosekunda", Few: "mikrosekundy", Other: "mikrosekúnd", Many: "mikrosekundy"},
+				Nanoseconds:  {Singular: "nanosekunda", Few: "nanosekundy", Other: "nanosekúnd", Many: "nanosekundy"},
+			},
+		},
+		Abbrs: map[string]map[string]string{
+			StyleShort: {
+				Centuries:    "stor",
+				Decades:      "desaťr",
+				Years:        "r",
+				Quarters:     "štvrťr",
+				Months:       "mes",
+				Weeks:        "týž",
+				Days:         "d",
+				Hours:        "h",
+				Minutes:      "min",
+				Seconds:      "s",
+				Milliseconds: "ms",
+				Microseconds: "µs",
+				Nanoseconds:  "ns",
+			},
+			StyleNarrow: {
+				Centuries:    "stor",
+				Decades:      "desaťr",
+				Years:        "r",
+				Quarters:     "štvrťr",
+				Months:       "mes",
+				Weeks:        "t",
+				Days:         "d",
+				Hours:        "h",
+				Minutes:      "m",
+				Seconds:      "s",
+				Milliseconds: "ms",
+				Microseconds: "µs",
+				Nanoseconds:  "ns",
+			},
+		},
+		Fraction:     Many,
+		DecimalPoint: ",",
+	}
+
+	// Lithuanian — литовский язык: "2 savaitės 18 valandų 22 minutės 3 sekundės".
+	Lithuanian = &LocaleTable{
+		Plural: pluralLithuanian,
+		Names: map[string]map[string]map[string]string{
+			Nominative: {
+				Centuries:    {Singular: "amžius", Few: "amžiai", Other: "amžių", Many: "amžiaus"},
+				Decades:      {Singular: "dešimtmetis", Few: "dešimtmečiai", Other: "dešimtmečių", Many: "dešimtmečio"},
+				Years:        {Singular: "metai", Few: "metai", Other: "metų", Many: "metų"},
+				Quarters:     {Singular: "ketvirtis", Few: "ketvirčiai", Other: "ketvirčių", Many: "ketvirčio"},
+				Months:       {Singular: "mėnuo", Few: "mėnesiai", Other: "mėnesių", Many: "mėnesio"},
+				Weeks:        {Singular: "savaitė", Few: "savaitės", Other: "savaičių", Many: "savaitės"},
+				Days:         {Singular: "diena", Few: "dienos", Other: "dienų", Many: "dienos"},
+				Hours:        {Singular: "valanda", Few: "valandos", Other: "valandų", Many: "valandos"},
+				Minutes:      {Singular: "minutė", Few: "minutės", Other: "minučių", Many: "minutės"},
+				Seconds:      {Singular: "sekundė", Few: "sekundės", Other: "sekundžių", Many: "sekundės"},
+				Milliseconds: {Singular: "milisekundė", Few: "milisekundės", Other: "milisekundžių", Many: "milisekundės"},
+				Microseconds: {Singular: "mikrosekundė", Few: "mikrosekundės", Other: "mikrosekundžių", Many: "mikrosekundės"},
+				Nanoseconds:  {Singular: "nanosekundė", Few: "nanosekundės", Other: "nanosekundžių", Many: "nanosekundės"},
+			},
+		},
+		Abbrs: map[string]map[string]string{
+			StyleShort: {
+				Centuries:    "amž",
+				Decades:      "dešimtm",
+				Years:        "m",
+				Quarters:     "ketv",
+				Months:       "mėn",
+				Weeks:        "sav",
+				Days:         "d",
+				Hours:        "val",
+				Minutes:      "min",
+				Seconds:      "s",
+				Milliseconds: "ms",
+				Microseconds: "µs",
+				Nanoseconds:  "ns",
+			},
+			StyleNarrow: {
+				Centuries:    "amž",
+				Decades:      "dešimtm",
+				Years:        "m",
+				Quarters:     "ketv",
+				Months:       "mėn",
+				Weeks:        "sav",
+				Days:         "d",
+				Hours:        "h",
+				Minutes:      "min",
+				Seconds:      "s",
+				Milliseconds: "ms",
+				Microseconds: "µs",
+				Nanoseconds:  "ns",
+			},
+		},
+		Fraction:     Many,
+		DecimalPoint: ",",
+	}
+
+	// Latvian — латышский язык: "2 nedēļas 18 stundu 22 minūtes 3 sekundes".
+	Latvian = &LocaleTable{
+		Plural: pluralLatvian,
+		Names: map[string]map[string]map[string]string{
+			Nominative: {
+				Centuries:    {Zero: "gadsimtu", Singular: "gadsimts", Other: "gadsimti"},
+				Decades:      {Zero: "desmitgažu", Singular: "desmitgade", Other: "desmitgades"},
+				Years:        {Zero: "gadu", Singular: "gads", Other: "gadi"},
+				Quarters:     {Zero: "ceturkšņu", Singular: "ceturksnis", Other: "ceturkšņi"},
+				Months:       {Zero: "mēnešu", Singular: "mēnesis", Other: "mēneši"},
+				Weeks:        {Zero: "nedēļu", Singular: "nedēļa", Other: "nedēļas"},
+				Days:         {Zero: "dienu", Singular: "diena", Other: "dienas"},
+				Hours:        {Zero: "stundu", Singular: "stunda", Other: "stundas"},
+				Minutes:      {Zero: "minūšu", Singular: "minūte", Other: "minūtes"},
+				Seconds:      {Zero: "sekunžu", Singular: "sekunde", Other: "sekundes"},
+				Milliseconds: {Zero: "milisekunžu", Singular: "milisekunde", Other: "milisekundes"},
+				Microseconds: {Zero: "mikrosekunžu", Singular: "mikrosekunde", Other: "mikrosekundes"},
+				Nanoseconds:  {Zero: "nanosekunžu", Singular: "nanosekunde", Other: "nanosekundes"},
+			},
+		},
+		Abbrs: map[string]map[string]string{
+			StyleShort: {
+				Centuries:    "gs",
+				Decades:      "desmitg",
+				Years:        "g",
+				Quarters:     "cet",
+				Months:       "mēn",
+				Weeks:        "ned",
+				Days:         "d",
+				Hours:        "st",
+				Minutes:      "min",
+				Seconds:      "s",
+				Milliseconds: "ms",
+				Microseconds: "µs",
+				Nanoseconds:  "ns",
+			},
+			StyleNarrow: {
+				Centuries:    "gs",
+				Decades:      "desmitg",
+				Years:        "g",
+				Quarters:     "cet",
+				Months:       "mēn",
+				Weeks:        "n",
+				Days:         "d",
+				Hours:        "h",
+				Minutes:      "m",
+				Seconds:      "s",
+				Milliseconds: "ms",
+				Microseconds: "µs",
+				Nanoseconds:  "ns",
+			},
+		},
+		DecimalPoint: ",",
+	}
+)
@@ -0,0 +1,171 @@
+package durufmt
+
+import (
+	"testing"
+	"time"
+)
+
+// TestUkrainianBelarusian тестирует вывод на украинском и белорусском языках.
+func TestUkrainianBelarusian(t *testing.T) {
+	timeduration := (354 * time.Hour) + (22 * time.Minute) + (3 * time.Second)
+
+	testLocales := []struct {
+		name     string
+		test     *Durafmt
+		expected string
+	}{
+		{"украинский", Parse(timeduration).InLocale(Ukrainian), "2 тижні 18 годин 22 хвилини 3 секунди"},
+		{
+			"украинский, 1 и 21",
+			Parse(time.Hour + 21*time.Minute + 11*time.Second).InLocale(Ukrainian),
+			"1 година 21 хвилина 11 секунд",
+		},
+		{"украинский, винительный падеж", Parse(5 * time.Minute).InLocale(Ukrainian).InCase(Accusative), "5 хвилин"},
+		{"украинский, винительный падеж для 1", Parse(time.Minute).InLocale(Ukrainian).InCase(Accusative), "1 хвилину"},
+		{"украинский, родительный падеж", Parse(2 * time.Hour).InLocale(Ukrainian).InCase(Genitive), "2 годин"},
+		{"украинский, творительный падеж", Parse(3 * time.Hour).InLocale(Ukrainian).InCase(Instrumental), "3 годинами"},
+		{"украинский, местный падеж", Parse(time.Hour).InLocale(Ukrainian).InCase(Prepositional), "1 годині"},
+		{"украинский, дробное число", Parse(90*time.Minute).InLocale(Ukrainian).Decimal(Hours, 1), "1,5 години"},
+		{
+			"украинский, StyleShort",
+			Parse(timeduration).InLocale(Ukrainian).InStyle(StyleShort),
+			"2 тиж 18 год 22 хв 3 с",
+		},
+		{"белорусский", Parse(timeduration).InLocale(Belarusian), "2 тыдні 18 гадзін 22 хвіліны 3 секунды"},
+		{"белорусский, 1 и 21", Parse(24*time.Hour + 21*time.Minute).InLocale(Belarusian), "1 дзень 21 хвіліна"},
+		{"белорусский, 5", Parse(5 * 24 * time.Hour).InLocale(Belarusian), "5 дзён"},
+		{"белорусский, винительный падеж для 1", Parse(time.Hour).InLocale(Belarusian).InCase(Accusative), "1 гадзіну"},
+		{
+			"белорусский, винительный падеж для 12",
+			Parse(12 * time.Hour).InLocale(Belarusian).InCase(Accusative),
+			"12 гадзін",
+		},
+		{
+			"белорусский, творительный падеж",
+			Parse(2 * time.Minute).InLocale(Belarusian).InCase(Instrumental),
+			"2 хвілінамі",
+		},
+		{"белорусский, дробное число", Parse(90*time.Second).InLocale(Belarusian).Decimal(Minutes, 1), "1,5 хвіліны"},
+	}
+
+	for _, table := range testLocales {
+		t.Run(table.name, func(t *testing.T) {
+			if result := table.test.String(); result != table.expected {
+				t.Errorf("String() = %q, ожидалось %q", result, table.expected)
+			}
+		})
+	}
+}
+
+// TestOtherPluralCategories тестирует языки с категориями множественного числа, отличными от русских.
+func TestOtherPluralCategories(t *testing.T) {
+	timeduration := (354 * time.Hour) + (22 * time.Minute) + (3 * time.Second)
+
+	testLocales := []struct {
+		name     string
+		test     *Durafmt
+		expected string
+	}{
+		{"польский", Parse(timeduration).InLocale(Polish), "2 tygodnie 18 godzin 22 minuty 3 sekundy"},
+		{
+			"польский, 1, 12 и 5",
+			Parse(time.Hour + 12*time.Minute + 5*time.Second).InLocale(Polish),
+			"1 godzina 12 minut 5 sekund",
+		},
+		{"польский, 21 и 24", Parse(21*time.Minute + 24*time.Second).InLocale(Polish), "21 minut 24 sekundy"},
+		{"польский, дробное число", Parse(90*time.Minute).InLocale(Polish).Decimal(Hours, 1), "1,5 godziny"},
+		{"чешский", Parse(timeduration).InLocale(Czech), "2 týdny 18 hodin 22 minut 3 sekundy"},
+		{
+			"чешский, 1, 4 и 5",
+			Parse(time.Hour + 4*time.Minute + 5*time.Second).InLocale(Czech),
+			"1 hodina 4 minuty 5 sekund",
+		},
+		{
+			"чешский, 21 и 22",
+			Parse(21*24*time.Hour + 22*time.Hour).InLocale(Czech).LimitToUnit(Days),
+			"21 dní 22 hodin",
+		},
+		{"чешский, дробное число", Parse(90*time.Minute).InLocale(Czech).Decimal(Hours, 1), "1,5 hodiny"},
+		{"словацкий", Parse(timeduration).InLocale(Slovak), "2 týždne 18 hodín 22 minút 3 sekundy"},
+		{"словацкий, 1", Parse(25 * time.Hour).InLocale(Slovak), "1 deň 1 hodina"},
+		{"словацкий, дробное число", Parse(90*time.Minute).InLocale(Slovak).Decimal(Hours, 1), "1,5 hodiny"},
+		{"литовский", Parse(timeduration).InLocale(Lithuanian), "2 savaitės 18 valandų 22 minutės 3 sekundės"},
+		{
+			"литовский, 21, 11 и 9",
+			Parse(21*time.Hour + 11*time.Minute + 9*time.Second).InLocale(Lithuanian),
+			"21 valanda 11 minučių 9 sekundės",
+		},
+		{"литовский, 10 и 30", Parse(10*time.Minute + 30*time.Second).InLocale(Lithuanian), "10 minučių 30 sekundžių"},
+		{"литовский, дробное число", Parse(90*time.Minute).InLocale(Lithuanian).Decimal(Hours, 1), "1,5 valandos"},
+		{"латышский", Parse(timeduration).InLocale(Latvian), "2 nedēļas 18 stundu 22 minūtes 3 sekundes"},
+		{
+			"латышский, 21, 11 и 10",
+			Parse(21*time.Hour + 11*time.Minute + 10*time.Second).InLocale(Latvian),
+			"21 stunda 11 minūšu 10 sekunžu",
+		},
+		{"латышский, ноль", Parse(0).InLocale(Latvian), "0 sekunžu"},
+		{"латышский, дробное число", Parse(90*time.Minute).InLocale(Latvian).Decimal(Hours, 1), "1,5 stundas"},
+	}
+
+	for _, table := range testLocales {
+		t.Run(table.name, func(t *testing.T) {
+			if result := table.test.String(); result != table.expected {
+				t.Errorf("String() = %q, ожидалось %q", result, table.expected)
+			}
+		})
+	}
+}
+
+// TestPluralRules тестирует правила выбора категории множественного числа.
+func TestPluralRules(t *testing.T) {
+	testRules := []struct {
+		rule     func(int64) string
+		v        int64
+		expected string
+	}{
+		{pluralPolish, 0, Many},
+		{pluralPolish, 1, Singular},
+		{pluralPolish, 21, Many},
+		{pluralPolish, 22, Few},
+		{pluralPolish, 112, Many},
+		{pluralCzech, 0, Other},
+		{pluralCzech, 1, Singular},
+		{pluralCzech, 4, Few},
+		{pluralCzech, 22, Other},
+		{pluralLithuanian, 1, Singular},
+		{pluralLithuanian, 9, Few},
+		{pluralLithuanian, 10, Other},
+		{pluralLithuanian, 11, Other},
+		{pluralLithuanian, 19, Other},
+		{pluralLithuanian, 21, Singular},
+		{pluralLithuanian, 29, Few},
+		{pluralLatvian, 0, Zero},
+		{pluralLatvian, 1, Singular},
+		{pluralLatvian, 11, Zero},
+		{pluralLatvian, 19, Zero},
+		{pluralLatvian, 21, Singular},
+		{pluralLatvian, 22, Other},
+		{pluralLatvian, -30, Zero},
+	}
+
+	for _, table := range testRules {
+		if result := table.rule(table.v); result != table.expected {
+			t.Errorf("%d: категория %q, ожидалось %q", table.v, result, table.expected)
+		}
+	}
+}
+
+// TestDeclensionNamesComplete тестирует, что у встроенных языков есть все формы всех единиц времени во всех падежах.
+func TestDeclensionNamesComplete(t *testing.T) {
+	for tag, locale := range map[string]Locale{"uk": Ukrainian, "be": Belarusian} {
+		for _, gramCase := range caseOrder {
+			for _, unit := range units {
+				for _, category := range []string{Singular, Some, Many, Other} {
+					if locale.UnitName(gramCase, unit, category) == "" {
+						t.Errorf("%s: нет формы %s/%s/%s", tag, gramCase, unit, category)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,413 @@
+package durufmt
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ParseError описывает ошибку разбора продолжительности, записанной по-русски.
+type ParseError struct {
+	Input   string // Исходная строка.
+	Pos     int    // Позиция ошибки в символах (не байтах), начиная с нуля.
+	Message string // Описание ошибки.
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("durafmt_ru: %s (позиция %d) во входном параметре %q", e.Message, e.Pos+1, e.Input)
+}
+
+var (
+	// unitAliasesExtra содержит распространённые сокращения, которых нет в unitsAbbr и unitsShort.
+	unitAliasesExtra = map[string]string{
+		"сек":  Seconds,
+		"мсек": Milliseconds,
+		"дн":   Days,
+		"сут":  Days,
+		"us":   Microseconds, // Написания микросекунд, которые понимает time.ParseDuration().
+		"μs":   Microseconds,
+	}
+
+	unitAliases   = buildUnitAliases()
+	numeralValues = buildNumeralValues()
+)
+
+// buildUnitAliases собирает все известные написания единиц времени: все формы из unitNames
+// во всех падежах, формы слова "сутки", сокращения и обозначения time.Duration ("1h30m").
+func buildUnitAliases() map[string]string {
+	aliases := make(map[string]string)
+
+	for _, unit := range units {
+		for _, gramCase := range []string{Nominative, Genitive, Dative, Accusative, Instrumental, Prepositional} {
+			for _, form := range unitForms(gramCase, unit) {
+				aliases[normalizeWord(form)] = unit
+			}
+		}
+
+		for _, abbrs := range unitsAbbr {
+			aliases[normalizeWord(abbrs[unit])] = unit
+		}
+	}
+
+	for idx, short := range unitsShort {
+		if short != "" {
+			aliases[short] = units[idx]
+		}
+	}
+
+	for _, forms := range sutkiNames {
+		for _, form := range forms {
+			aliases[form] = Days
+		}
+	}
+
+	for alias, unit := range unitAliasesExtra {
+		aliases[alias] = unit
+	}
+
+	return aliases
+}
+
+// buildNumeralValues собирает все формы числительных из таблиц, используемых spellNumber.
+func buildNumeralValues() map[string]float64 {
+	values := make(map[string]float64)
+
+	add := func(forms [6]string, value float64) {
+		for _, form := range forms {
+			if form != "" {
+				values[normalizeWord(form)] = value
+			}
+		}
+	}
+
+	add(numeralZero, 0)
+
+	for _, forms := range numeralOne {
+		add(forms, 1)
+	}
+
+	for _, forms := range numeralTwo {
+		add(forms, 2)
+	}
+
+	for n, forms := range numeralUnits {
+		add(forms, float64(n))
+	}
+
+	for n, forms := range numeralCollective {
+		add(forms, float64(n))
+	}
+
+	for n, forms := range numeralTens {
+		add(forms, float64(n*10))
+	}
+
+	for n, forms := range numeralHundreds {
+		add(forms, float64(n*100))
+	}
+
+	add([6]string{"полтора", "полторы", "полутора"}, 1.5)
+
+	return values
+}
+
+// numeralScale возвращает множитель, если word — название разряда ("тысяча", "миллиона").
+func numeralScale(word string) (float64, bool) {
+	scale := 1000.0
+
+	for _, s := range numeralScales {
+		for _, forms := range s.forms {
+			for _, form := range forms {
+				if normalizeWord(form) == word {
+					return scale, true
+				}
+			}
+		}
+
+		scale *= 1000
+	}
+
+	return 0, false
+}
+
+// normalizeWord приводит слово к нижнему регистру и заменяет "ё" на "е".
+func normalizeWord(word string) string {
+	return strings.ReplaceAll(strings.ToLower(word), "ё", "е")
+}
+
+const (
+	tokenNumber = iota
+	tokenWord
+	tokenPunct
+)
+
+type token struct {
+	kind int
+	text string
+	pos  int // Позиция в символах.
+}
+
+// tokenize разбивает строку на числа, слова и знаки препинания.
+func tokenize(input string) ([]token, error) {
+	runes := []rune(input)
+	tokens := make([]token, 0, 8)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) ||
+				(runes[i] == ',' || runes[i] == '.') && i+1 < len(runes) && unicode.IsDigit(runes[i+1])) {
+				i++
+			}
+
+			tokens = append(tokens, token{tokenNumber, string(runes[start:i]), start})
+		case unicode.IsLetter(r):
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) ||
+				runes[i] == '-' && i+1 < len(runes) && unicode.IsLetter(runes[i+1])) {
+				i++
+			}
+
+			tokens = append(tokens, token{tokenWord, normalizeWord(string(runes[start:i])), start})
+		case r == ',' || r == '.' || r == ';':
+			tokens = append(tokens, token{tokenPunct, string(r), i})
+			i++
+		case r == '-' || r == '−':
+			tokens = append(tokens, token{tokenPunct, "-", i})
+			i++
+		default:
+			return nil, &ParseError{input, i, fmt.Sprintf("неожиданный символ %q", r)}
+		}
+	}
+
+	return tokens, nil
+}
+
+// ParseRussian создаёт структуру *Durafmt из продолжительности, записанной по-русски, например
+// "2 часа 15 минут", "1,5 ч", "90 мин", "полтора часа", "двадцать одна секунда" или
+// "1 час, 5 минут и 3 секунды". Понимает все формы названий единиц времени, сокращения, обозначения
+// time.Duration ("1h30m") и числа прописью. Единицы времени должны идти по убыванию и не повторяться.
+// В случае неправильных входных данных возвращает *ParseError с позицией ошибки.
+func ParseRussian(input string) (*Durafmt, error) {
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var total time.Duration
+
+	negative := false
+	components := 0
+
+	// sep — разделитель после последнего элемента, sepPos — его позиция.
+	sep, sepPos := "", 0
+
+	// last — индекс в units последней единицы времени, -1 — ещё не было.
+	last := -1
+
+	i := 0
+	if len(tokens) > 0 && (tokens[0].text == "-" || tokens[0].text == "минус") {
+		negative = true
+		i++
+	}
+
+	for i < len(tokens) {
+		tok := tokens[i]
+
+		// Разделители между элементами: запятые, точки и союз "и".
+		if tok.kind == tokenPunct || tok.text == "и" {
+			if components == 0 || tok.text == "-" {
+				return nil, &ParseError{input, tok.pos, fmt.Sprintf("неожиданный символ %q", tok.text)}
+			}
+
+			i++
+
+			// Точка после названия единицы времени — сокращение или конец предложения: "3 дн.", "30 минут.".
+			if tok.text == "." && sep == "" && tokens[i-2].kind == tokenWord {
+				continue
+			}
+
+			// Между элементами стоит один разделитель, только союз "и" может идти после запятой:
+			// "2 часа, 15 минут, и 3 секунды".
+			if sep != "" && (tok.text != "и" || sep != "," && sep != ";") {
+				return nil, &ParseError{input, tok.pos, fmt.Sprintf("лишний разделитель %q", tok.text)}
+			}
+
+			sep, sepPos = tok.text, tok.pos
+
+			continue
+		}
+
+		sep = ""
+
+		amount, hasAmount, next, err := parseAmount(input, tokens, i)
+		if err != nil {
+			return nil, err
+		}
+
+		i = next
+
+		if i >= len(tokens) || tokens[i].kind != tokenWord {
+			pos := len([]rune(input))
+			if i < len(tokens) {
+				pos = tokens[i].pos
+			}
+
+			return nil, &ParseError{input, pos, "ожидалась единица времени"}
+		}
+
+		unit, ok := unitAliases[tokens[i].text]
+
+		switch {
+		case ok && !hasAmount:
+			// "час" без числа означает "1 час".
+			amount = 1
+		case !ok && !hasAmount && strings.HasPrefix(tokens[i].text, "пол"):
+			// "полчаса", "пол-минуты".
+			unit, ok = unitAliases[strings.TrimPrefix(strings.TrimPrefix(tokens[i].text, "пол"), "-")]
+			amount = 0.5
+		}
+
+		if !ok {
+			return nil, &ParseError{input, tokens[i].pos, fmt.Sprintf("неизвестная единица времени %q", tokens[i].text)}
+		}
+
+		idx := unitIndex(unit)
+
+		switch {
+		case idx == last:
+			return nil, &ParseError{input, tokens[i].pos, fmt.Sprintf("повторяется единица времени %q", tokens[i].text)}
+		case idx < last:
+			return nil, &ParseError{input, tokens[i].pos,
+				fmt.Sprintf("единица времени %q идёт после меньшей единицы", tokens[i].text)}
+		}
+
+		last = idx
+
+		value, ok := unitValue(amount, unitDurations[idx])
+		if !ok || total > math.MaxInt64-value {
+			return nil, &ParseError{input, tokens[i].pos, "слишком большая продолжительность"}
+		}
+
+		total += value
+		components++
+		i++
+	}
+
+	if components == 0 {
+		return nil, &ParseError{input, 0, "не указана продолжительность"}
+	}
+
+	if sep != "" {
+		return nil, &ParseError{input, sepPos, fmt.Sprintf("после %q не указана продолжительность", sep)}
+	}
+
+	if negative {
+		total = -total
+	}
+
+	return defaultFormatter.Parse(total), nil
+}
+
+// parseAmount разбирает количество, начиная с токена i: число цифрами или прописью.
+// Возвращает количество, признак его наличия и индекс следующего токена.
+func parseAmount(input string, tokens []token, i int) (float64, bool, int, error) {
+	tok := tokens[i]
+
+	if tok.kind == tokenNumber {
+		amount, err := strconv.ParseFloat(strings.Replace(tok.text, ",", ".", 1), 64)
+		if err != nil {
+			return 0, false, i, &ParseError{input, tok.pos, fmt.Sprintf("неправильное число %q", tok.text)}
+		}
+
+		return amount, true, i + 1, nil
+	}
+
+	// Число прописью: "две тысячи триста двадцать одна". В каждой тройке цифр сотни, десятки и единицы
+	// встречаются не больше одного раза и по убыванию, названия разрядов тоже идут по убыванию.
+	var total, current float64
+
+	hasAmount := false
+	place := 4 // Разряд последнего числительного в текущей тройке цифр, 4 — ещё не было.
+	lastScale := math.Inf(1)
+
+	for ; i < len(tokens) && tokens[i].kind == tokenWord; i++ {
+		tok := tokens[i]
+
+		if value, ok := numeralValues[tok.text]; ok {
+			p := numeralPlace(value)
+			if p >= place || place == 2 && value >= 10 || (value == 0 || value == 1.5) && hasAmount {
+				return 0, false, i, &ParseError{input, tok.pos, fmt.Sprintf("неожиданное числительное %q", tok.text)}
+			}
+
+			current += value
+			hasAmount = true
+			place = p
+
+			continue
+		}
+
+		if scale, ok := numeralScale(tok.text); ok {
+			if scale >= lastScale {
+				return 0, false, i, &ParseError{input, tok.pos, fmt.Sprintf("неожиданное числительное %q", tok.text)}
+			}
+
+			if current == 0 {
+				current = 1
+			}
+
+			place = 4
+			lastScale = scale
+
+			total += current * scale
+			current = 0
+			hasAmount = true
+
+			continue
+		}
+
+		break
+	}
+
+	return total + current, hasAmount, i, nil
+}
+
+// numeralPlace возвращает разряд числительного со значением value: 3 — сотни, 2 — десятки, 1 — единицы,
+// числа от 10 до 19, ноль и "полтора".
+func numeralPlace(value float64) int {
+	switch {
+	case value >= 100:
+		return 3
+	case value >= 20:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// unitValue переводит количество единиц времени в time.Duration, проверяя переполнение.
+func unitValue(amount float64, unit time.Duration) (time.Duration, bool) {
+	if amount == math.Trunc(amount) && amount < math.MaxInt64 {
+		n := int64(amount)
+		if n != 0 && int64(unit) > math.MaxInt64/n {
+			return 0, false
+		}
+
+		return time.Duration(n) * unit, true
+	}
+
+	value := math.Round(amount * float64(unit))
+	if value >= math.MaxInt64 {
+		return 0, false
+	}
+
+	return time.Duration(value), true
+}
@@ -0,0 +1,135 @@
+package durufmt
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestParseRussian тестирует разбор продолжительности, записанной по-русски.
+func TestParseRussian(t *testing.T) {
+	testStrings := []struct {
+		test     string
+		expected time.Duration
+	}{
+		{"2 часа 15 минут", 2*time.Hour + 15*time.Minute},
+		{"1 час", time.Hour},
+		{"час", time.Hour},
+		{"5 лет", 5 * 365 * 24 * time.Hour},
+		{"1 год 2 недели", (365 + 14) * 24 * time.Hour},
+		{"1,5 ч", 90 * time.Minute},
+		{"1.5 ч", 90 * time.Minute},
+		{"90 мин", 90 * time.Minute},
+		{"90мин", 90 * time.Minute},
+		{"2ч 15м 3с", 2*time.Hour + 15*time.Minute + 3*time.Second},
+		{"10 сек", 10 * time.Second},
+		{"3 дн.", 3 * 24 * time.Hour},
+		{"100 мс", 100 * time.Millisecond},
+		{"5 мкс", 5 * time.Microsecond},
+		{"полтора часа", 90 * time.Minute},
+		{"полторы минуты", 90 * time.Second},
+		{"полчаса", 30 * time.Minute},
+		{"пол-минуты", 30 * time.Second},
+		{"двадцать одна секунда", 21 * time.Second},
+		{"две недели", 14 * 24 * time.Hour},
+		{"Одну минуту", time.Minute},
+		{"сто двадцать минут", 2 * time.Hour},
+		{"две тысячи секунд", 2000 * time.Second},
+		{"тысяча миллисекунд", time.Second},
+		{"2 часа, 15 минут и 3 секунды", 2*time.Hour + 15*time.Minute + 3*time.Second},
+		{"1 час и 30 минут.", 90 * time.Minute},
+		{"-2 часа", -2 * time.Hour},
+		{"минус три дня", -3 * 24 * time.Hour},
+		{"двумя днями", 2 * 24 * time.Hour},
+		{"трех секундах", 3 * time.Second},
+		{"0 минут", 0},
+		{"2 часа, 15 минут, и 3 секунды", 2*time.Hour + 15*time.Minute + 3*time.Second},
+		{"2 ч., 5 мин.", 2*time.Hour + 5*time.Minute},
+		{"полторы тысячи секунд", 1500 * time.Second},
+		{"две тысячи двадцать две секунды", 2022 * time.Second},
+		{"один миллион триста секунд", 1000300 * time.Second},
+		{"1h30m", 90 * time.Minute},
+		{"5 µs", 5 * time.Microsecond},
+		{"5μs 3ns", 5*time.Microsecond + 3*time.Nanosecond},
+		{"2 недели 3 дня", 17 * 24 * time.Hour},
+	}
+
+	for _, table := range testStrings {
+		d, err := ParseRussian(table.test)
+		if err != nil {
+			t.Errorf("ParseRussian(%q): %v", table.test, err)
+
+			continue
+		}
+
+		if d.Duration() != table.expected {
+			t.Errorf("ParseRussian(%q).Duration() = %v, ожидалось %v", table.test, d.Duration(), table.expected)
+		}
+	}
+
+	d, err := ParseRussian("2 часа 15 минут")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result := d.String(); result != "2 часа 15 минут" {
+		t.Errorf("ParseRussian(\"2 часа 15 минут\").String() = %q", result)
+	}
+}
+
+// TestParseRussianErrors тестирует ошибки разбора и их позиции.
+func TestParseRussianErrors(t *testing.T) {
+	testStrings := []struct {
+		test string
+		pos  int
+	}{
+		{"", 0},
+		{"   ", 0},
+		{"2", 1},
+		{"2 часа 15", 9},
+		{"2 попугая", 2},
+		{"через 5 минут", 0},
+		{"в течение двух часов", 0},
+		{"2 часа, 15 кг", 11},
+		{"2 часа # 15 минут", 7},
+		{", 2 часа", 0},
+		{"2 часа - 15 минут", 7},
+		{"100000000 лет", 10},
+		{"два три часа", 4},
+		{"пять двадцать минут", 5},
+		{"двадцать двенадцать минут", 9},
+		{"сто сто минут", 4},
+		{"двадцать ноль минут", 9},
+		{"тысяча тысяча секунд", 7},
+		{"тысяча миллион секунд", 7},
+		{"2 часа, , 5 минут", 8},
+		{"2 часа и и 5 минут", 9},
+		{"2 часа и", 7},
+		{"2 часа,", 6},
+		{"1 час 2 часа", 8},
+		{"1 день 1 сутки", 9},
+		{"5 минут 2 часа", 10},
+		{"2 часа, 15 минут и 1 час", 21},
+		{"1h2h", 3},
+	}
+
+	for _, table := range testStrings {
+		_, err := ParseRussian(table.test)
+		if err == nil {
+			t.Errorf("ParseRussian(%q): ожидалась ошибка", table.test)
+
+			continue
+		}
+
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("ParseRussian(%q): ошибка %v не является *ParseError", table.test, err)
+
+			continue
+		}
+
+		if parseErr.Pos != table.pos {
+			t.Errorf("ParseRussian(%q): позиция ошибки %d, ожидалась %d (%v)", table.test, parseErr.Pos, table.pos, err)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package durufmt
+
+// adjectives — встроенный словарь прилагательных для Period(). Ключ — форма мужского рода именительного
+// падежа, формы хранятся по родам (Masculine, Feminine, Neuter, Plural) в порядке caseIndex.
+var adjectives = map[string]map[string][6]string{
+	"последний": {
+		Masculine: {"последний", "последнего", "последнему", "последний", "последним", "последнем"},
+		Feminine:  {"последняя", "последней", "последней", "последнюю", "последней", "последней"},
+		Neuter:    {"последнее", "последнего", "последнему", "последнее", "последним", "последнем"},
+		Plural:    {"последние", "последних", "последним", "последние", "последними", "последних"},
+	},
+	"ближайший": {
+		Masculine: {"ближайший", "ближайшего", "ближайшему", "ближайший", "ближайшим", "ближайшем"},
+		Feminine:  {"ближайшая", "ближайшей", "ближайшей", "ближайшую", "ближайшей", "ближайшей"},
+		Neuter:    {"ближайшее", "ближайшего", "ближайшему", "ближайшее", "ближайшим", "ближайшем"},
+		Plural:    {"ближайшие", "ближайших", "ближайшим", "ближайшие", "ближайшими", "ближайших"},
+	},
+	"следующий": {
+		Masculine: {"следующий", "следующего", "следующему", "следующий", "следующим", "следующем"},
+		Feminine:  {"следующая", "следующей", "следующей", "следующую", "следующей", "следующей"},
+		Neuter:    {"следующее", "следующего", "следующему", "следующее", "следующим", "следующем"},
+		Plural:    {"следующие", "следующих", "следующим", "следующие", "следующими", "следующих"},
+	},
+	"предыдущий": {
+		Masculine: {"предыдущий", "предыдущего", "предыдущему", "предыдущий", "предыдущим", "предыдущем"},
+		Feminine:  {"предыдущая", "предыдущей", "предыдущей", "предыдущую", "предыдущей", "предыдущей"},
+		Neuter:    {"предыдущее", "предыдущего", "предыдущему", "предыдущее", "предыдущим", "предыдущем"},
+		Plural:    {"предыдущие", "предыдущих", "предыдущим", "предыдущие", "предыдущими", "предыдущих"},
+	},
+	"каждый": {
+		Masculine: {"каждый", "каждого", "каждому", "каждый", "каждым", "каждом"},
+		Feminine:  {"каждая", "каждой", "каждой", "каждую", "каждой", "каждой"},
+		Neuter:    {"каждое", "каждого", "каждому", "каждое", "каждым", "каждом"},
+		Plural:    {"каждые", "каждых", "каждым", "каждые", "каждыми", "каждых"},
+	},
+}
+
+// prepositionCases хранит падежи, которых требуют предлоги Period().
+var prepositionCases = map[string]string{
+	"за":         Accusative,
+	"на":         Accusative,
+	"через":      Accusative,
+	"в":          Accusative,
+	"в течение":  Genitive,
+	"в пределах": Genitive,
+	"в ходе":     Genitive,
+	"до":         Genitive,
+	"после":      Genitive,
+	"около":      Genitive,
+	"для":        Genitive,
+	"по":         Dative,
+	"перед":      Instrumental,
+	"над":        Instrumental,
+	"о":          Prepositional,
+}
+
+// Period выводит продолжительность как период с предлогом и прилагательным, согласованным с первым
+// выводимым элементом по числу, роду и падежу: "за последний час", "за последнюю неделю",
+// "за последние 3 дня", "в течение ближайших 5 минут", "каждые сутки". Прилагательное задаётся формой
+// мужского рода именительного падежа: "последний", "ближайший", "следующий", "предыдущий", "каждый".
+// Если продолжительность — ровно одна единица времени, число не выводится: "за последний час".
+// Падеж определяется предлогом ("за", "на", "через", "в" — винительный; "в течение", "до", "после",
+// "около", "для" — родительный; "по" — дательный...), для неизвестного предлога или preposition = ""
+// используется падеж из InCase(). Прилагательное не из словаря выводится без изменений и в других языках
+// не согласуется. Отрицательная продолжительность и прилагательное из словаря в других языках выводятся
+// без периода: "-5 минут", "1 година". adjective = "" означает вывод без периода.
+func (d *Durafmt) Period(preposition, adjective string) *Durafmt {
+	return d.with(WithPeriod(preposition, adjective))
+}
+
+// appendPeriod дописывает в b предлог, прилагательное и продолжительность.
+func (d *Durafmt) appendPeriod(b []byte) []byte {
+	forms, known := lookupAdjective(d.adjective)
+	if d.duration < 0 || known && !d.russian() {
+		return d.with(WithPeriod("", "")).appendTo(b)
+	}
+
+	c := d.with(func(o *options) {
+		o.preposition = ""
+		o.adjective = ""
+
+		if gramCase, ok := prepositionCases[normalizeWord(d.preposition)]; ok {
+			o.gramCase = gramCase
+		}
+	})
+
+	if d.preposition != "" {
+		b = append(b, d.preposition...)
+		b = append(b, ' ')
+	}
+
+	if !known {
+		b = append(b, d.adjective...)
+		b = append(b, ' ')
+
+		return c.appendTo(b)
+	}
+
+	gramCase := c.gramCase
+	if gramCase == "" {
+		gramCase = Nominative
+	}
+
+	gender := Plural
+
+	unit, n, count := c.leadingUnit()
+	if count == 1 && pluralCategory(n) == Singular {
+		gender = c.unitGender(unit)
+	}
+
+	b = append(b, forms[gender][caseIndex[gramCase]]...)
+	b = append(b, ' ')
+
+	// "за последний час": число не выводится, если продолжительность — ровно одна единица времени
+	// и название единицы не сокращено.
+	if count == 1 && n == 1 && c.unitAbbr(unit) == "" {
+		return c.appendUnitName(b, gramCase, unit, Singular)
+	}
+
+	return c.appendTo(b)
+}
+
+// lookupAdjective ищет прилагательное в словаре. Слова сравниваются без учёта регистра и различия "е" и "ё".
+func lookupAdjective(word string) (map[string][6]string, bool) {
+	forms, ok := adjectives[normalizeWord(word)]
+
+	return forms, ok
+}
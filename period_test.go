@@ -0,0 +1,90 @@
+package durufmt
+
+import (
+	"testing"
+	"time"
+)
+
+// TestPeriod тестирует согласование прилагательного периода с продолжительностью.
+func TestPeriod(t *testing.T) {
+	day := 24 * time.Hour
+
+	testPeriods := []struct {
+		name     string
+		test     *Durafmt
+		expected string
+	}{
+		{"мужской род без числа", Parse(time.Hour).Period("за", "последний"), "за последний час"},
+		{"женский род без числа", Parse(7*day).Period("за", "последний"), "за последнюю неделю"},
+		{"3", Parse(3*day).Period("за", "последний"), "за последние 3 дня"},
+		{"5", Parse(5*time.Minute).Period("за", "последний"), "за последние 5 минут"},
+		{"21, мужской род", Parse(21*day).LimitToUnit(Days).Period("за", "последний"), "за последний 21 день"},
+		{"21, женский род", Parse(21*time.Minute).Period("за", "последний"), "за последнюю 21 минуту"},
+		{"несколько единиц", Parse(time.Hour+30*time.Minute).Period("за", "последний"), "за последние 1 час 30 минут"},
+		{"дробное число", Parse(90*time.Minute).Decimal(Hours, 1).Period("за", "последний"), "за последние 1,5 часа"},
+		{"родительный падеж, 5", Parse(5*time.Minute).Period("в течение", "ближайший"), "в течение ближайших 5 минут"},
+		{
+			"родительный падеж, мужской род",
+			Parse(time.Hour).Period("в течение", "ближайший"),
+			"в течение ближайшего часа",
+		},
+		{
+			"родительный падеж, женский род",
+			Parse(time.Minute).Period("в течение", "ближайший"),
+			"в течение ближайшей минуты",
+		},
+		{
+			"средний род",
+			Parse(3650*day).LimitToUnit(Decades).Period("в течение", "следующий"),
+			"в течение следующего десятилетия",
+		},
+		{"дательный падеж", Parse(2*time.Hour).Period("по", "предыдущий"), "по предыдущим 2 часам"},
+		{"без предлога", Parse(time.Hour).Period("", "каждый"), "каждый час"},
+		{"без предлога, 2", Parse(2*time.Hour).Period("", "каждый"), "каждые 2 часа"},
+		{
+			"без предлога, винительный падеж",
+			Parse(time.Minute).Period("", "каждый").InCase(Accusative),
+			"каждую минуту",
+		},
+		{
+			"без предлога, творительный падеж",
+			Parse(time.Minute).Period("", "каждый").InCase(Instrumental),
+			"каждой минутой",
+		},
+		{"сутки", Parse(day).Period("за", "последний").DayUnit(DayUnitSutki), "за последние сутки"},
+		{
+			"сутки прописью",
+			Parse(2*day).Period("за", "последний").DayUnit(DayUnitSutki).InWords(true),
+			"за последние двое суток",
+		},
+		{"прописью", Parse(3*day).Period("за", "последний").InWords(true), "за последние три дня"},
+		{"StyleShort", Parse(time.Hour).Period("за", "последний").InStyle(StyleShort), "за последний 1 ч"},
+		{"регистр", Parse(time.Hour).Period("За", "Последний"), "За последний час"},
+		{"прилагательное не из словаря", Parse(time.Hour).Period("за", "весь"), "за весь 1 час"},
+		{"английский без прилагательного", Parse(time.Hour).Period("for the last", "").InLocale(English), "1 hour"},
+		{
+			"английский",
+			Parse(2*time.Hour).Period("for the last", "several").InLocale(English),
+			"for the last several 2 hours",
+		},
+		{"украинский", Parse(time.Hour).InLocale(Ukrainian).Period("за", "последний"), "1 година"},
+		{"отрицательная продолжительность", Parse(-5*time.Minute).Period("за", "последний"), "-5 минут"},
+		{
+			"отрицательная продолжительность без предлога",
+			Parse(-time.Hour).Period("", "каждый").InCase(Genitive),
+			"-1 часа",
+		},
+	}
+
+	for _, table := range testPeriods {
+		t.Run(table.name, func(t *testing.T) {
+			if result := table.test.String(); result != table.expected {
+				t.Errorf("String() = %q, ожидалось %q", result, table.expected)
+			}
+		})
+	}
+
+	if result := NewFormatter(WithPeriod("за", "последний")).Format(7 * day); result != "за последнюю неделю" {
+		t.Errorf("Format() = %q, ожидалось %q", result, "за последнюю неделю")
+	}
+}
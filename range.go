@@ -0,0 +1,118 @@
+package durufmt
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	// Стили вывода диапазона продолжительностей.
+	RangeDash   = "dash"    // "2–3 часа" (по умолчанию).
+	RangeFromTo = "from-to" // "от 2 до 3 часов", только на русском языке.
+)
+
+// FormatRange форматирует диапазон продолжительностей с настройками по умолчанию: "2–3 часа",
+// см. Formatter.FormatRange().
+func FormatRange(min, max time.Duration) string {
+	return defaultFormatter.FormatRange(min, max)
+}
+
+// FormatRange форматирует диапазон продолжительностей от min до max в общей единице времени:
+// "2–3 часа", "90–120 минут", а со стилем RangeFromTo — "от 2 до 5 минут". Общая единица — наибольшая,
+// в которой обе границы выражаются целым числом (с учётом LimitToUnit()); если задан LimitToSmallestUnit(),
+// границы округляются до него (см. Round()). Название единицы согласуется с верхней границей.
+// Совпадающие границы выводятся одним значением: "2 часа". Границы берутся по модулю, а если min больше max,
+// они меняются местами. Decimal(), Predicate() и Period() при выводе диапазона не учитываются.
+func (f *Formatter) FormatRange(min, max time.Duration) string {
+	d := (&Durafmt{options: &f.options}).with(func(o *options) {
+		o.decimal = false
+		o.predicate = ""
+		o.adjective = ""
+	})
+
+	from, _ := (&Durafmt{duration: min}).abs()
+	to, _ := (&Durafmt{duration: max}).abs()
+
+	if from > to {
+		from, to = to, from
+	}
+
+	b := make([]byte, 0, 64)
+
+	if to == 0 {
+		return string(d.appendTo(b))
+	}
+
+	unit := d.rangeUnit(from, to)
+	low, high := d.rangeValue(from, unit), d.rangeValue(to, unit)
+
+	if low == high {
+		return string(d.appendUnit(b, unit, high))
+	}
+
+	if d.rangeStyle == RangeFromTo && d.russian() {
+		d = d.with(WithCase(Genitive))
+
+		b = append(b, "от "...)
+		b = d.appendNumber(b, unit, low)
+		b = append(b, " до "...)
+
+		return string(d.appendUnit(b, unit, high))
+	}
+
+	b = d.appendNumber(b, unit, low)
+	b = append(b, "–"...)
+
+	return string(d.appendUnit(b, unit, high))
+}
+
+// rangeUnit выбирает общую единицу времени диапазона from–to: наибольшую, которая не больше to и в которой
+// обе границы выражаются целым числом, но не меньше smallestUnit.
+func (d *Durafmt) rangeUnit(from, to time.Duration) string {
+	start := unitIndex(d.limitUnit)
+	if start < 0 {
+		start = unitIndex(Years)
+	}
+
+	start = d.workUnitIndex(start)
+
+	smallest := d.workUnitIndex(unitIndex(d.smallestUnit))
+	if smallest < start {
+		smallest = len(units) - 1
+	}
+
+	for idx := start; idx < smallest; idx++ {
+		unit := units[idx]
+		if optionalUnits[idx] && unit != d.limitUnit {
+			continue
+		}
+
+		size := d.unitSize(unit)
+		if to >= size && from%size == 0 && to%size == 0 {
+			return unit
+		}
+	}
+
+	return units[smallest]
+}
+
+// rangeValue переводит границу диапазона в количество единиц времени unit с округлением по d.rounding.
+func (d *Durafmt) rangeValue(duration time.Duration, unit string) int64 {
+	size := d.unitSize(unit)
+	v := int64(duration / size)
+
+	if roundUp(d.rounding, v, duration%size, size, false) {
+		v++
+	}
+
+	return v
+}
+
+// appendNumber дописывает в b количество v единиц времени unit цифрами или прописью, без названия единицы.
+func (d *Durafmt) appendNumber(b []byte, unit string, v int64) []byte {
+	if d.unitAbbr(unit) != "" || !d.spelled() {
+		return strconv.AppendInt(b, v, 10)
+	}
+
+	return append(b, spellNumber(v, d.unitGender(unit), d.gramCase)...)
+}
@@ -0,0 +1,60 @@
+package durufmt
+
+import (
+	"testing"
+	"time"
+)
+
+// TestFormatRange тестирует вывод диапазонов продолжительностей.
+func TestFormatRange(t *testing.T) {
+	day := 24 * time.Hour
+	fromTo := WithRangeStyle(RangeFromTo)
+
+	testRanges := []struct {
+		formatter *Formatter
+		min, max  time.Duration
+		expected  string
+	}{
+		{NewFormatter(), 2 * time.Hour, 3 * time.Hour, "2–3 часа"},
+		{NewFormatter(), 2 * time.Minute, 5 * time.Minute, "2–5 минут"},
+		{NewFormatter(), time.Minute, 2 * time.Minute, "1–2 минуты"},
+		{NewFormatter(), 20 * time.Minute, 21 * time.Minute, "20–21 минута"},
+		{NewFormatter(), 0, 30 * time.Minute, "0–30 минут"},
+		{NewFormatter(), 90 * time.Minute, 2 * time.Hour, "90–120 минут"},
+		{NewFormatter(), 7 * day, 14 * day, "1–2 недели"},
+		{NewFormatter(), 2 * day, 7 * day, "2–7 дней"},
+		{NewFormatter(), 3 * time.Hour, 2 * time.Hour, "2–3 часа"},
+		{NewFormatter(), -3 * time.Hour, -2 * time.Hour, "2–3 часа"},
+		{NewFormatter(), 2 * time.Hour, 2 * time.Hour, "2 часа"},
+		{NewFormatter(), 0, 0, "0 секунд"},
+		{NewFormatter(WithLimitToUnit(Hours)), 2 * day, 3 * day, "48–72 часа"},
+		{NewFormatter(WithLimitToSmallestUnit(Hours)), 90 * time.Minute, 5 * time.Hour, "1–5 часов"},
+		{NewFormatter(WithLimitToSmallestUnit(Hours), WithRounding(RoundHalfUp)),
+			90 * time.Minute, 5 * time.Hour, "2–5 часов"},
+		{NewFormatter(WithStyle(StyleShort)), 2 * time.Hour, 3 * time.Hour, "2–3 ч"},
+		{NewFormatter(WithWords(true)), 2 * time.Hour, 3 * time.Hour, "два–три часа"},
+		{NewFormatter(WithWords(true)), time.Minute, 2 * time.Minute, "одна–две минуты"},
+		{NewFormatter(WithCase(Genitive)), 2 * time.Hour, 3 * time.Hour, "2–3 часов"},
+		{NewFormatter(WithDayUnit(DayUnitSutki)), 2 * day, 3 * day, "2–3 суток"},
+		{NewFormatter(WithLocale(English)), 2 * time.Hour, 3 * time.Hour, "2–3 hours"},
+		{NewFormatter(fromTo), 2 * time.Minute, 5 * time.Minute, "от 2 до 5 минут"},
+		{NewFormatter(fromTo), 2 * time.Hour, 3 * time.Hour, "от 2 до 3 часов"},
+		{NewFormatter(fromTo), time.Minute, 21 * time.Minute, "от 1 до 21 минуты"},
+		{NewFormatter(fromTo), 7 * day, 14 * day, "от 1 до 2 недель"},
+		{NewFormatter(fromTo, WithWords(true)), 2 * time.Hour, 3 * time.Hour, "от двух до трёх часов"},
+		{NewFormatter(fromTo, WithWords(true)), time.Minute, 2 * time.Minute, "от одной до двух минут"},
+		{NewFormatter(fromTo), 2 * time.Hour, 2 * time.Hour, "2 часа"},
+		{NewFormatter(fromTo, WithLocale(English)), 2 * time.Hour, 3 * time.Hour, "2–3 hours"},
+		{NewFormatter(WithDecimal(Hours, 1), WithPredicate("осталось")), 2 * time.Hour, 3 * time.Hour, "2–3 часа"},
+	}
+
+	for _, table := range testRanges {
+		if result := table.formatter.FormatRange(table.min, table.max); result != table.expected {
+			t.Errorf("FormatRange(%v, %v) = %q, ожидалось %q", table.min, table.max, result, table.expected)
+		}
+	}
+
+	if result := FormatRange(2*time.Hour, 3*time.Hour); result != "2–3 часа" {
+		t.Errorf("FormatRange() = %q, ожидалось %q", result, "2–3 часа")
+	}
+}
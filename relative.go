@@ -0,0 +1,100 @@
+package durufmt
+
+import "time"
+
+// RelativeThresholds задаёт пороги, при которых Relative() вместо точной продолжительности
+// выводит слова "только что", "вчера", "позавчера" и т.д. Нулевое значение порога отключает
+// соответствующее слово.
+type RelativeThresholds struct {
+	JustNow            time.Duration // Интервалы короче JustNow выводятся как "только что" (или "сейчас").
+	Yesterday          time.Duration // Начиная с Yesterday выводится "вчера" (или "завтра").
+	DayBeforeYesterday time.Duration // Начиная с DayBeforeYesterday выводится "позавчера" (или "послезавтра").
+	Exact              time.Duration // Начиная с Exact снова выводится точная продолжительность.
+}
+
+// DefaultRelativeThresholds используется в Relative(), если пороги не заданы через Thresholds().
+var DefaultRelativeThresholds = RelativeThresholds{
+	JustNow:            10 * time.Second,
+	Yesterday:          24 * time.Hour,
+	DayBeforeYesterday: 48 * time.Hour,
+	Exact:              72 * time.Hour,
+}
+
+// Thresholds устанавливает пороги для Relative().
+func (d *Durafmt) Thresholds(thresholds RelativeThresholds) *Durafmt {
+	return d.with(WithThresholds(thresholds))
+}
+
+// Relative форматирует продолжительность как время относительно текущего момента.
+// Положительная продолжительность считается будущим ("через 3 часа"), отрицательная —
+// прошлым ("3 часа назад"), как у значения time.Until(t).
+func Relative(dinput time.Duration) string {
+	return Parse(dinput).Relative()
+}
+
+// Relative форматирует *Durafmt как время относительно текущего момента: "через 5 минут",
+// "5 минут назад", "только что", "вчера", "послезавтра". Знак продолжительности определяет
+// направление: минус означает прошлое. Relative() всегда выводит результат на русском языке; названия,
+// заменённые через CustomLocale() на основе Russian, сохраняются.
+func (d *Durafmt) Relative() string {
+	thresholds := DefaultRelativeThresholds
+	if d.options != nil && d.thresholds != nil {
+		thresholds = *d.thresholds
+	}
+
+	c := d.with(func(o *options) {
+		o.gramCase = Accusative
+		if !isRussian(o.locale) {
+			o.locale = Russian
+		}
+		o.predicate = ""
+		o.adjective = ""
+		o.preposition = ""
+	})
+	abs, past := d.abs()
+
+	yesterdayEnd := thresholds.DayBeforeYesterday
+	if yesterdayEnd == 0 {
+		yesterdayEnd = thresholds.Exact
+	}
+
+	switch {
+	case abs == 0 || abs < thresholds.JustNow:
+		if past || abs == 0 {
+			return "только что"
+		}
+
+		return "сейчас"
+	case inThreshold(abs, thresholds.DayBeforeYesterday, thresholds.Exact):
+		if past {
+			return "позавчера"
+		}
+
+		return "послезавтра"
+	case inThreshold(abs, thresholds.Yesterday, yesterdayEnd):
+		if past {
+			return "вчера"
+		}
+
+		return "завтра"
+	}
+
+	// Направление передают слова "через" и "назад", поэтому продолжительность выводится без знака.
+	formatted := string(c.appendAbs(make([]byte, 0, 64), abs, false))
+
+	if past {
+		return formatted + " назад"
+	}
+
+	return "через " + formatted
+}
+
+// inThreshold проверяет, попадает ли duration в интервал [from, to). Нулевой from отключает интервал,
+// нулевой to означает отсутствие верхней границы.
+func inThreshold(duration, from, to time.Duration) bool {
+	if from == 0 || duration < from {
+		return false
+	}
+
+	return to == 0 || duration < to
+}
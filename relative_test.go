@@ -0,0 +1,68 @@
+package durufmt
+
+import (
+	"testing"
+	"time"
+)
+
+// TestRelative тестирует форматирование относительного времени с порогами по умолчанию.
+func TestRelative(t *testing.T) {
+	testTimes := []struct {
+		test     time.Duration
+		expected string
+	}{
+		{0, "только что"},
+		{-3 * time.Second, "только что"},
+		{3 * time.Second, "сейчас"},
+		{5 * time.Minute, "через 5 минут"},
+		{-5 * time.Minute, "5 минут назад"},
+		{1 * time.Minute, "через 1 минуту"},
+		{-1 * time.Minute, "1 минуту назад"},
+		{-21 * time.Minute, "21 минуту назад"},
+		{3 * time.Hour, "через 3 часа"},
+		{(2 * 168 * time.Hour) + time.Minute, "через 2 недели 1 минуту"},
+		{-25 * time.Hour, "вчера"},
+		{25 * time.Hour, "завтра"},
+		{-50 * time.Hour, "позавчера"},
+		{50 * time.Hour, "послезавтра"},
+		{-72 * time.Hour, "3 дня назад"},
+		{168 * time.Hour, "через 1 неделю"},
+	}
+
+	for _, table := range testTimes {
+		result := Relative(table.test)
+		if result != table.expected {
+			t.Errorf("Relative(%q) = %q. получено %q, ожидалось %q",
+				table.test, result, result, table.expected)
+		}
+	}
+}
+
+// TestRelativeThresholds тестирует форматирование относительного времени с заданными порогами.
+func TestRelativeThresholds(t *testing.T) {
+	thresholds := RelativeThresholds{JustNow: time.Minute, Yesterday: 24 * time.Hour}
+
+	testTimes := []struct {
+		test     time.Duration
+		expected string
+	}{
+		{-30 * time.Second, "только что"},
+		{-1 * time.Minute, "1 минуту назад"},
+		{-25 * time.Hour, "вчера"},
+		{-50 * time.Hour, "вчера"},
+		{50 * time.Hour, "завтра"},
+	}
+
+	for _, table := range testTimes {
+		result := Parse(table.test).Thresholds(thresholds).Relative()
+		if result != table.expected {
+			t.Errorf("Parse(%q).Thresholds(...).Relative() = %q. получено %q, ожидалось %q",
+				table.test, result, result, table.expected)
+		}
+	}
+
+	result := ParseShort(-26 * time.Hour).Thresholds(RelativeThresholds{}).Relative()
+	if result != "1 день назад" {
+		t.Errorf("ParseShort(-26h).Thresholds({}).Relative() = %q, ожидалось %q", result, "1 день назад")
+	}
+}
@@ -0,0 +1,177 @@
+package durufmt
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// Способы округления младшей выводимой единицы времени.
+	RoundTruncate = "truncate"  // Отбрасывать остаток (по умолчанию): 1 час 59 минут → 1 час.
+	RoundHalfUp   = "half-up"   // До ближайшего, половина — от нуля: 1 час 30 минут → 2 часа.
+	RoundHalfEven = "half-even" // До ближайшего, половина — к чётному: 1 час 30 минут → 2 часа, 2 часа 30 минут → 2 часа.
+	RoundCeil     = "ceil"      // В сторону плюс бесконечности: 1 час 1 минута → 2 часа, -1 час 59 минут → -1 час.
+	RoundFloor    = "floor"     // В сторону минус бесконечности: 1 час 59 минут → 1 час, -1 час 1 минута → -2 часа.
+)
+
+// LimitToSmallestUnit устанавливает формат вывода, вы не получите в итоговой строке единицу времени меньше
+// заданной. Всё, что меньше unit, округляется (см. Round()) в последнюю выводимую единицу.
+// unit = "" означает отсутствие ограничений.
+func (d *Durafmt) LimitToSmallestUnit(unit string) *Durafmt {
+	return d.with(WithLimitToSmallestUnit(unit))
+}
+
+// Round устанавливает способ округления младшей выводимой единицы времени: RoundTruncate, RoundHalfUp,
+// RoundHalfEven, RoundCeil или RoundFloor. Округление применяется и к LimitFirstN(): с RoundHalfUp
+// 1 час 59 минут, ограниченные одним элементом, превращаются в "2 часа", а не в "1 час".
+// mode = "" означает RoundTruncate.
+func (d *Durafmt) Round(mode string) *Durafmt {
+	return d.with(WithRounding(mode))
+}
+
+// unitIndex возвращает индекс единицы времени в units или -1, если такой единицы нет.
+func unitIndex(unit string) int {
+	switch unit {
+	case Centuries:
+		return 0
+	case Decades:
+		return 1
+	case Years:
+		return 2
+	case Quarters:
+		return 3
+	case Months:
+		return 4
+	case Weeks:
+		return 5
+	case Days:
+		return 6
+	case Hours:
+		return 7
+	case Minutes:
+		return 8
+	case Seconds:
+		return 9
+	case Milliseconds:
+		return 10
+	case Microseconds:
+		return 11
+	case Nanoseconds:
+		return 12
+	default:
+		return -1
+	}
+}
+
+// roundUp сообщает, нужно ли увеличить на единицу значение v младшей выводимой единицы времени
+// размером size, если после неё остался остаток rem. Значения и остаток берутся по модулю,
+// negative означает отрицательную продолжительность.
+func roundUp(mode string, v int64, rem, size time.Duration, negative bool) bool {
+	if rem <= 0 {
+		return false
+	}
+
+	switch mode {
+	case RoundHalfUp:
+		return rem >= size-rem
+	case RoundHalfEven:
+		return rem > size-rem || rem == size-rem && v%2 == 1
+	case RoundCeil:
+		return !negative
+	case RoundFloor:
+		return negative
+	default:
+		return false
+	}
+}
+
+// roundedDurationValues раскладывает модуль продолжительности abs по единицам времени и округляет его до
+// младшей выводимой единицы, перенося переполнение в старшие единицы: 59 минут 59 секунд,
+// округлённые до минут, превращаются в 1 час.
+func (d *Durafmt) roundedDurationValues(abs time.Duration, negative bool, smallest string) durationValues {
+	idx := unitIndex(smallest)
+
+	if d.calendar() {
+		start, end := *d.anchor, d.anchor.Add(abs)
+		if negative {
+			start, end = d.anchor.Add(-abs), *d.anchor
+		}
+
+		values := calendarDurationValues(start, end, d.limitUnit)
+		if idx < 0 {
+			return values
+		}
+
+		truncateDurationValues(&values, idx)
+		shown := calendarAdd(start, &values)
+
+		values[idx]++
+		next := calendarAdd(start, &values)
+		values[idx]--
+
+		if roundUp(d.rounding, values[idx], end.Sub(shown), next.Sub(shown), negative) {
+			values = calendarDurationValues(start, next, d.limitUnit)
+			truncateDurationValues(&values, idx)
+		}
+
+		return values
+	}
+
+	values := d.fixedValues(abs)
+	if idx = d.workUnitIndex(idx); idx < 0 {
+		return values
+	}
+
+	rem := truncateDurationValues(&values, idx)
+	size := d.unitSize(units[idx])
+
+	if roundUp(d.rounding, values[idx], rem, size, negative) {
+		// Продолжительность без остатка. У math.MinInt64 остаток rem включает наносекунду, которой нет в abs.
+		truncated := abs - rem
+		if d.duration == math.MinInt64 {
+			truncated++
+		}
+
+		// Округлённая вверх продолжительность может не поместиться в time.Duration: тогда без переноса
+		// в старшие единицы увеличивается только младшая выводимая единица.
+		if truncated > math.MaxInt64-size {
+			values[idx]++
+
+			return values
+		}
+
+		values = d.fixedValues(truncated + size)
+		truncateDurationValues(&values, idx)
+	}
+
+	return values
+}
+
+// truncateDurationValues обнуляет единицы времени младше units[idx] и возвращает их сумму.
+func truncateDurationValues(values *durationValues, idx int) time.Duration {
+	var rem time.Duration
+
+	for i := idx + 1; i < len(values); i++ {
+		rem += time.Duration(values[i]) * unitDurations[i]
+		values[i] = 0
+	}
+
+	return rem
+}
+
+// nthNonZeroUnit возвращает индекс единицы времени n-го ненулевого элемента продолжительности или -1,
+// если ненулевых элементов меньше n.
+func nthNonZeroUnit(values *durationValues, n int) int {
+	for idx, v := range values {
+		if v == 0 {
+			continue
+		}
+
+		n--
+		if n == 0 {
+			return idx
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,165 @@
+package durufmt
+
+import (
+	"testing"
+	"time"
+)
+
+// TestLimitToSmallestUnit тестирует ограничение минимальной единицы времени и округление.
+func TestLimitToSmallestUnit(t *testing.T) {
+	testTimes := []struct {
+		test         time.Duration
+		smallestUnit string
+		rounding     string
+		limitN       int
+		expected     string
+	}{
+		{(354 * time.Hour) + (22 * time.Minute) + (3240 * time.Millisecond), "", "", 0,
+			"2 недели 18 часов 22 минуты 3 секунды 240 миллисекунд"},
+		{(354 * time.Hour) + (22 * time.Minute) + (3240 * time.Millisecond), Seconds, "", 0,
+			"2 недели 18 часов 22 минуты 3 секунды"},
+		{(354 * time.Hour) + (22 * time.Minute) + (3240 * time.Millisecond), Minutes, "", 0,
+			"2 недели 18 часов 22 минуты"},
+		{(354 * time.Hour) + (22 * time.Minute) + (3240 * time.Millisecond), Minutes, "", 2,
+			"2 недели 18 часов"},
+		{(1 * time.Hour) + (59 * time.Minute), Hours, RoundTruncate, 0, "1 час"},
+		{(1 * time.Hour) + (59 * time.Minute), Hours, RoundHalfUp, 0, "2 часа"},
+		{(1 * time.Hour) + (29 * time.Minute), Hours, RoundHalfUp, 0, "1 час"},
+		{(1 * time.Hour) + (30 * time.Minute), Hours, RoundHalfUp, 0, "2 часа"},
+		{(1 * time.Hour) + (1 * time.Minute), Hours, RoundCeil, 0, "2 часа"},
+		{1 * time.Hour, Hours, RoundCeil, 0, "1 час"},
+		{(59 * time.Minute) + (59900 * time.Millisecond), Seconds, RoundHalfUp, 0, "1 час"},
+		{(23 * time.Hour) + (59 * time.Minute) + (31 * time.Second), Minutes, RoundHalfUp, 0, "1 день"},
+		{(23 * time.Hour) + (59 * time.Minute) + (31 * time.Second), Minutes, RoundHalfUp, 1, "1 день"},
+		{(3 * time.Hour) + (40 * time.Minute) + (31 * time.Second), Minutes, RoundHalfUp, 1, "4 часа"},
+		{(3 * time.Hour) + (40 * time.Minute) + (31 * time.Second), Minutes, RoundTruncate, 1, "3 часа"},
+		{-(1 * time.Hour) - (59 * time.Minute), Hours, RoundHalfUp, 0, "-2 часа"},
+		{-(1 * time.Hour) - (59 * time.Minute), Hours, RoundCeil, 0, "-1 час"},
+		{30 * time.Second, Minutes, RoundTruncate, 0, "0 минут"},
+		{30 * time.Second, Minutes, RoundHalfUp, 0, "1 минута"},
+		{1500 * time.Nanosecond, Microseconds, RoundHalfUp, 0, "2 микросекунды"},
+	}
+
+	for _, table := range testTimes {
+		result := Parse(table.test).LimitToSmallestUnit(table.smallestUnit).Round(table.rounding).
+			LimitFirstN(table.limitN).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).LimitToSmallestUnit(%q).Round(%q).LimitFirstN(%d).String() = %q, ожидалось %q",
+				table.test, table.smallestUnit, table.rounding, table.limitN, result, table.expected)
+		}
+	}
+
+	d, err := ParseString("0s")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result := d.LimitToSmallestUnit(Minutes).String(); result != "0 минут" {
+		t.Errorf("ParseString(\"0s\").LimitToSmallestUnit(Minutes).String() = %q, ожидалось %q", result, "0 минут")
+	}
+
+	if result := Parse(100 * time.Hour).LimitToUnit(Hours).LimitToSmallestUnit(Hours).String(); result != "100 часов" {
+		t.Errorf("Parse(100h).LimitToUnit(Hours).LimitToSmallestUnit(Hours).String() = %q", result)
+	}
+}
+
+// TestRoundLimitFirstN тестирует округление при ограничении количества элементов.
+func TestRoundLimitFirstN(t *testing.T) {
+	testTimes := []struct {
+		test     time.Duration
+		rounding string
+		limitN   int
+		expected string
+	}{
+		{(1 * time.Hour) + (59 * time.Minute), "", 1, "1 час"},
+		{(1 * time.Hour) + (59 * time.Minute), RoundTruncate, 1, "1 час"},
+		{(1 * time.Hour) + (59 * time.Minute), RoundHalfUp, 1, "2 часа"},
+		{(1 * time.Hour) + (59 * time.Minute), RoundHalfEven, 1, "2 часа"},
+		{(1 * time.Hour) + (59 * time.Minute), RoundCeil, 1, "2 часа"},
+		{(1 * time.Hour) + (59 * time.Minute), RoundFloor, 1, "1 час"},
+		{(1 * time.Hour) + (30 * time.Minute), RoundHalfUp, 1, "2 часа"},
+		{(1 * time.Hour) + (30 * time.Minute), RoundHalfEven, 1, "2 часа"},
+		{(2 * time.Hour) + (30 * time.Minute), RoundHalfUp, 1, "3 часа"},
+		{(2 * time.Hour) + (30 * time.Minute), RoundHalfEven, 1, "2 часа"},
+		{(1 * time.Hour) + (1 * time.Minute), RoundCeil, 1, "2 часа"},
+		{(1 * time.Hour) + (1 * time.Minute), RoundFloor, 1, "1 час"},
+		{-(1 * time.Hour) - (1 * time.Minute), RoundCeil, 1, "-1 час"},
+		{-(1 * time.Hour) - (1 * time.Minute), RoundFloor, 1, "-2 часа"},
+		{-(1 * time.Hour) - (1 * time.Minute), RoundTruncate, 1, "-1 час"},
+		{(59 * time.Minute) + (59900 * time.Millisecond), RoundHalfUp, 1, "1 час"},
+		{(59 * time.Minute) + (59900 * time.Millisecond), RoundHalfUp, 2, "1 час"},
+		{(59 * time.Minute) + (59900 * time.Millisecond), RoundHalfUp, 3, "59 минут 59 секунд 900 миллисекунд"},
+		{(59 * time.Minute) + (59900 * time.Millisecond), RoundTruncate, 2, "59 минут 59 секунд"},
+		{(1 * time.Hour) + (29 * time.Minute) + (40 * time.Second), RoundHalfUp, 2, "1 час 30 минут"},
+		{(6 * 24 * time.Hour) + (23 * time.Hour), RoundHalfUp, 1, "1 неделя"},
+		{(8759 * time.Hour), RoundHalfUp, 1, "52 недели"},
+		{(17519 * time.Hour), RoundCeil, 2, "2 года"},
+	}
+
+	for _, table := range testTimes {
+		result := Parse(table.test).Round(table.rounding).LimitFirstN(table.limitN).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).Round(%q).LimitFirstN(%d).String() = %q, ожидалось %q",
+				table.test, table.rounding, table.limitN, result, table.expected)
+		}
+	}
+
+	if result := ParseShort(90 * time.Minute).Round(RoundHalfUp).String(); result != "2 часа" {
+		t.Errorf("ParseShort(90m).Round(RoundHalfUp).String() = %q, ожидалось %q", result, "2 часа")
+	}
+}
+
+// TestLimitToSmallestUnitCalendar тестирует округление при раскладке по календарю.
+func TestLimitToSmallestUnitCalendar(t *testing.T) {
+	from := time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC)
+
+	testTimes := []struct {
+		to           time.Time
+		smallestUnit string
+		rounding     string
+		expected     string
+	}{
+		{time.Date(2023, time.March, 15, 0, 0, 0, 0, time.UTC), Months, RoundTruncate, "1 месяц"},
+		{time.Date(2023, time.March, 15, 0, 0, 0, 0, time.UTC), Months, RoundHalfUp, "1 месяц"},
+		{time.Date(2023, time.March, 16, 0, 0, 0, 0, time.UTC), Months, RoundHalfUp, "2 месяца"},
+		{time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC), Months, RoundCeil, "2 месяца"},
+		{time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), Months, RoundHalfUp, "11 месяцев"},
+		{time.Date(2024, time.January, 20, 0, 0, 0, 0, time.UTC), Months, RoundHalfUp, "1 год"},
+		{time.Date(2023, time.March, 1, 13, 0, 0, 0, time.UTC), Days, RoundHalfUp, "1 месяц 2 дня"},
+	}
+
+	for _, table := range testTimes {
+		result := Between(from, table.to).LimitToSmallestUnit(table.smallestUnit).Round(table.rounding).String()
+		if result != table.expected {
+			t.Errorf("Between(%v, %v).LimitToSmallestUnit(%q).Round(%q).String() = %q, ожидалось %q",
+				from, table.to, table.smallestUnit, table.rounding, result, table.expected)
+		}
+	}
+}
+
+// TestUnitIndex проверяет, что unitIndex() и таблицы единиц времени соответствуют порядку units.
+func TestUnitIndex(t *testing.T) {
+	for idx, unit := range units {
+		if result := unitIndex(unit); result != idx {
+			t.Errorf("unitIndex(%q) = %d, ожидалось %d", unit, result, idx)
+		}
+	}
+
+	if result := unitIndex("fortnights"); result != -1 {
+		t.Errorf("unitIndex(%q) = %d, ожидалось -1", "fortnights", result)
+	}
+
+	if unitDurations[unitIndex(Quarters)] != 90*24*time.Hour || unitDurations[unitIndex(Hours)] != time.Hour {
+		t.Error("unitDurations не соответствует порядку units")
+	}
+
+	for _, unit := range []string{Centuries, Decades, Quarters, Months} {
+		if !optionalUnits[unitIndex(unit)] {
+			t.Errorf("optionalUnits: %q не отмечена", unit)
+		}
+	}
+
+	if optionalUnits[unitIndex(Years)] || optionalUnits[unitIndex(Weeks)] {
+		t.Error("optionalUnits: отмечены годы или недели")
+	}
+}
@@ -0,0 +1,67 @@
+package durufmt
+
+const (
+	// Варианты названия дня.
+	DayUnitDays  = "days"  // "1 день", "2 дня", "5 дней" (по умолчанию).
+	DayUnitSutki = "sutki" // "1 сутки", "2 суток", "5 суток", прописью — "одни сутки", "двое суток".
+)
+
+// sutkiNames хранит формы слова "сутки" по падежам. У него нет единственного числа, поэтому после 1, 21, 31...
+// стоит форма множественного числа того же падежа, а после 2, 3 и 4 — родительный падеж: "2 суток".
+var sutkiNames = map[string]map[string]string{
+	Nominative:    {Singular: "сутки", Some: "суток", Many: "суток", Other: "суток"},
+	Genitive:      {Singular: "суток", Some: "суток", Many: "суток", Other: "суток"},
+	Dative:        {Singular: "суткам", Some: "суткам", Many: "суткам", Other: "суток"},
+	Accusative:    {Singular: "сутки", Some: "суток", Many: "суток", Other: "суток"},
+	Instrumental:  {Singular: "сутками", Some: "сутками", Many: "сутками", Other: "суток"},
+	Prepositional: {Singular: "сутках", Some: "сутках", Many: "сутках", Other: "суток"},
+}
+
+// sutkiAbbr — сокращение слова "сутки" по ГОСТ 8.417.
+const sutkiAbbr = "сут"
+
+// DayUnit устанавливает вариант названия дня: DayUnitDays или DayUnitSutki. Сутки принято использовать
+// в расписаниях и SLA: "1 сутки 2 часа", "через двое суток". Вариант учитывается только в русском языке.
+// variant = "" означает DayUnitDays.
+func (d *Durafmt) DayUnit(variant string) *Durafmt {
+	return d.with(WithDayUnit(variant))
+}
+
+// sutki сообщает, выводятся ли дни как сутки.
+func (d *Durafmt) sutki(unit string) bool {
+	return unit == Days && d.dayUnit == DayUnitSutki && d.russian()
+}
+
+// unitName возвращает название единицы времени unit с учётом варианта названия дня.
+func (d *Durafmt) unitName(grammaticalCase, unit, category string) string {
+	if d.sutki(unit) {
+		forms, ok := sutkiNames[grammaticalCase]
+		if !ok {
+			forms = sutkiNames[Nominative]
+		}
+
+		return forms[category]
+	}
+
+	return d.lang().UnitName(grammaticalCase, unit, category)
+}
+
+// unitAbbr возвращает сокращённое название единицы времени unit в текущем стиле или "", если стиль
+// не использует сокращения.
+func (d *Durafmt) unitAbbr(unit string) string {
+	abbr := d.lang().UnitAbbr(d.style, unit)
+	if abbr != "" && d.sutki(unit) {
+		return sutkiAbbr
+	}
+
+	return abbr
+}
+
+// unitGender возвращает род единицы времени unit, с которым согласуется число прописью.
+func (d *Durafmt) unitGender(unit string) string {
+	if d.sutki(unit) {
+		return Plural
+	}
+
+	return localeGender(d.lang(), unit)
+}
@@ -0,0 +1,115 @@
+package durufmt
+
+import (
+	"testing"
+	"time"
+)
+
+// TestDayUnitSutki тестирует вывод дней как суток.
+func TestDayUnitSutki(t *testing.T) {
+	day := 24 * time.Hour
+
+	testSutki := []struct {
+		name     string
+		test     *Durafmt
+		expected string
+	}{
+		{"1", Parse(day).DayUnit(DayUnitSutki), "1 сутки"},
+		{"2", Parse(2 * day).DayUnit(DayUnitSutki), "2 суток"},
+		{"5", Parse(5 * day).DayUnit(DayUnitSutki), "5 суток"},
+		{"21", Parse(21 * day).DayUnit(DayUnitSutki).LimitToUnit(Days), "21 сутки"},
+		{"с часами", Parse(day + 2*time.Hour).DayUnit(DayUnitSutki), "1 сутки 2 часа"},
+		{"DayUnitDays", Parse(day).DayUnit(DayUnitDays), "1 день"},
+		{"пустой вариант", Parse(day).DayUnit(""), "1 день"},
+		{"родительный падеж", Parse(day).DayUnit(DayUnitSutki).InCase(Genitive), "1 суток"},
+		{"винительный падеж", Parse(day).DayUnit(DayUnitSutki).InCase(Accusative), "1 сутки"},
+		{"творительный падеж", Parse(3 * day).DayUnit(DayUnitSutki).InCase(Instrumental), "3 сутками"},
+		{"StyleShort", Parse(day).DayUnit(DayUnitSutki).InStyle(StyleShort), "1 сут"},
+		{"StyleNarrow", Parse(day).DayUnit(DayUnitSutki).InStyle(StyleNarrow), "1сут"},
+		{"дробное число", Parse(36*time.Hour).DayUnit(DayUnitSutki).Decimal(Days, 1), "1,5 суток"},
+		{"прописью, 1", Parse(day).DayUnit(DayUnitSutki).InWords(true), "одни сутки"},
+		{"прописью, 2", Parse(2 * day).DayUnit(DayUnitSutki).InWords(true), "двое суток"},
+		{"прописью, 3", Parse(3 * day).DayUnit(DayUnitSutki).InWords(true), "трое суток"},
+		{
+			"прописью, родительный падеж для 4",
+			Parse(4 * day).DayUnit(DayUnitSutki).InWords(true).InCase(Genitive),
+			"четырёх суток",
+		},
+		{
+			"прописью, творительный падеж для 2",
+			Parse(2 * day).DayUnit(DayUnitSutki).InWords(true).InCase(Instrumental),
+			"двумя сутками",
+		},
+		{
+			"прописью, дательный падеж для 3",
+			Parse(3 * day).DayUnit(DayUnitSutki).InWords(true).InCase(Dative),
+			"трём суткам",
+		},
+		{
+			"прописью, винительный падеж для 2",
+			Parse(2 * day).DayUnit(DayUnitSutki).InWords(true).InCase(Accusative),
+			"двое суток",
+		},
+		{"прописью, 5", Parse(5 * day).DayUnit(DayUnitSutki).InWords(true), "пять суток"},
+		{"прописью, 12", Parse(12 * day).DayUnit(DayUnitSutki).InWords(true).LimitToUnit(Days), "двенадцать суток"},
+		{"прописью, 21", Parse(21 * day).DayUnit(DayUnitSutki).InWords(true).LimitToUnit(Days), "двадцать одни сутки"},
+		{
+			"прописью, 1000",
+			Parse(1000 * day).DayUnit(DayUnitSutki).InWords(true).LimitToUnit(Days),
+			"одна тысяча суток",
+		},
+		{"прописью, 20", Parse(20 * day).DayUnit(DayUnitSutki).InWords(true).LimitToUnit(Days), "двадцать суток"},
+		{
+			"прописью, родительный падеж для 20",
+			Parse(20 * day).DayUnit(DayUnitSutki).InWords(true).LimitToUnit(Days).InCase(Genitive),
+			"двадцати суток",
+		},
+		{"прописью, 22", Parse(22 * day).DayUnit(DayUnitSutki).InWords(true).LimitToUnit(Days), "двадцать два суток"},
+		{"прописью, 23", Parse(23 * day).DayUnit(DayUnitSutki).InWords(true).LimitToUnit(Days), "двадцать три суток"},
+		{"прописью, 100", Parse(100 * day).DayUnit(DayUnitSutki).InWords(true).LimitToUnit(Days), "сто суток"},
+		{"прописью, 103", Parse(103 * day).DayUnit(DayUnitSutki).InWords(true).LimitToUnit(Days), "сто три суток"},
+		{
+			"прописью, 1002",
+			Parse(1002 * day).DayUnit(DayUnitSutki).InWords(true).LimitToUnit(Days),
+			"одна тысяча два суток",
+		},
+		// В других языках вариант не учитывается.
+		{"английский", Parse(day).DayUnit(DayUnitSutki).InLocale(English), "1 day"},
+	}
+
+	for _, table := range testSutki {
+		t.Run(table.name, func(t *testing.T) {
+			if result := table.test.String(); result != table.expected {
+				t.Errorf("String() = %q, ожидалось %q", result, table.expected)
+			}
+		})
+	}
+
+	if result := Parse(4 * day).DayUnit(DayUnitSutki).Relative(); result != "через 4 суток" {
+		t.Errorf("Relative() = %q, ожидалось %q", result, "через 4 суток")
+	}
+}
+
+// TestParseRussianSutki тестирует разбор продолжительности в сутках.
+func TestParseRussianSutki(t *testing.T) {
+	testStrings := map[string]time.Duration{
+		"1 сутки":       24 * time.Hour,
+		"двое суток":    48 * time.Hour,
+		"четверо суток": 96 * time.Hour,
+		"полсуток":      12 * time.Hour,
+		"полтора суток": 36 * time.Hour,
+	}
+
+	for input, expected := range testStrings {
+		d, err := ParseRussian(input)
+		if err != nil {
+			t.Errorf("ParseRussian(%q): %v", input, err)
+
+			continue
+		}
+
+		if d.Duration() != expected {
+			t.Errorf("ParseRussian(%q) = %v, ожидалось %v", input, d.Duration(), expected)
+		}
+	}
+}
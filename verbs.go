@@ -0,0 +1,134 @@
+package durufmt
+
+import (
+	"math"
+	"strconv"
+)
+
+// verb описывает глагол из словаря Predicate(): его формы по родам и числам и падеж, которого
+// глагол требует от продолжительности.
+type verb struct {
+	forms    map[string]string // Род (Masculine, Feminine, Neuter) или Plural → форма глагола.
+	gramCase string            // Падеж продолжительности. Пустое значение — именительный.
+}
+
+// verbs — встроенный словарь глаголов. Ключ — форма, которая используется с большинством чисел.
+var verbs = map[string]verb{
+	"осталось":      agreeingVerb("остался", "осталась", "осталось", "остались"),
+	"прошло":        agreeingVerb("прошёл", "прошла", "прошло", "прошли"),
+	"истекло":       agreeingVerb("истёк", "истекла", "истекло", "истекли"),
+	"длилось":       agreeingVerb("длился", "длилась", "длилось", "длились"),
+	"минуло":        agreeingVerb("минул", "минула", "минуло", "минули"),
+	"пролетело":     agreeingVerb("пролетел", "пролетела", "пролетело", "пролетели"),
+	"ушло":          agreeingVerb("ушёл", "ушла", "ушло", "ушли"),
+	"понадобилось":  agreeingVerb("понадобился", "понадобилась", "понадобилось", "понадобились"),
+	"потребовалось": agreeingVerb("потребовался", "потребовалась", "потребовалось", "потребовались"),
+	"остаётся":      agreeingVerb("остаётся", "остаётся", "остаётся", "остаются"),
+	"останется":     agreeingVerb("останется", "останется", "останется", "останутся"),
+	"пройдёт":       agreeingVerb("пройдёт", "пройдёт", "пройдёт", "пройдут"),
+	"истечёт":       agreeingVerb("истечёт", "истечёт", "истечёт", "истекут"),
+	"длится":        agreeingVerb("длится", "длится", "длится", "длятся"),
+	// Переходные глаголы не согласуются с продолжительностью, а требуют винительного падежа:
+	// "займёт 1 минуту".
+	"займёт":    {gramCase: Accusative},
+	"занимает":  {gramCase: Accusative},
+	"заняло":    {gramCase: Accusative},
+	"потребует": {gramCase: Accusative},
+}
+
+// agreeingVerb создаёт непереходный глагол, который согласуется с продолжительностью в именительном падеже.
+// Формы настоящего и будущего времени не различаются по родам, поэтому для них передаются одинаковые формы.
+func agreeingVerb(masculine, feminine, neuter, plural string) verb {
+	return verb{forms: map[string]string{Masculine: masculine, Feminine: feminine, Neuter: neuter, Plural: plural}}
+}
+
+// Predicate добавляет перед продолжительностью глагол-сказуемое из встроенного словаря, согласованный с
+// первым выводимым элементом: "остался 1 час", "осталась 1 минута", "осталось 2 минуты", "прошла 1 неделя",
+// "остались одни сутки". Глагол задаётся формой, которая используется с большинством чисел: "осталось",
+// "прошло", "истекло", "длилось", "минуло", "пролетело", "ушло", "понадобилось", "потребовалось",
+// "остаётся", "останется", "пройдёт", "истечёт", "длится". С глаголами "займёт", "занимает", "заняло"
+// и "потребует" продолжительность выводится в винительном падеже: "займёт 1 минуту".
+// Глагол не из словаря выводится без изменений. В других языках глагол не согласуется.
+// verb = "" означает вывод без глагола.
+func (d *Durafmt) Predicate(verb string) *Durafmt {
+	return d.with(WithPredicate(verb))
+}
+
+// appendPredicate дописывает в b глагол-сказуемое и продолжительность.
+func (d *Durafmt) appendPredicate(b []byte) []byte {
+	c := d.with(WithPredicate(""))
+
+	v, known := lookupVerb(d.predicate)
+	if !known || !d.russian() {
+		b = append(b, d.predicate...)
+		b = append(b, ' ')
+
+		return c.appendTo(b)
+	}
+
+	if v.gramCase != "" {
+		c = c.with(WithCase(v.gramCase))
+	}
+
+	form := d.predicate
+
+	if unit, n, count := c.leadingUnit(); count > 0 && pluralCategory(n) == Singular {
+		if f, ok := v.forms[c.unitGender(unit)]; ok {
+			form = f
+		}
+	}
+
+	b = append(b, form...)
+	b = append(b, ' ')
+
+	return c.appendTo(b)
+}
+
+// lookupVerb ищет глагол в словаре. Слова сравниваются без учёта регистра и различия "е" и "ё".
+func lookupVerb(word string) (verb, bool) {
+	if v, ok := verbs[word]; ok {
+		return v, true
+	}
+
+	word = normalizeWord(word)
+	for key, v := range verbs {
+		if normalizeWord(key) == word {
+			return v, true
+		}
+	}
+
+	return verb{}, false
+}
+
+// leadingUnit возвращает первую выводимую единицу времени, её количество и количество выводимых
+// элементов. Для дробного числа количество элементов равно 0: с ним слова согласуются так же, как с "5 минут".
+func (d *Durafmt) leadingUnit() (string, int64, int) {
+	abs, negative := d.abs()
+
+	if d.decimal {
+		unit := d.fractionUnit(abs)
+
+		precision := d.precision
+		if precision < 0 {
+			precision = 0
+		}
+
+		formatted := strconv.FormatFloat(float64(abs)/float64(d.unitSize(unit)), 'f', precision, 64)
+
+		value, err := strconv.ParseFloat(formatted, 64)
+		if err != nil || value != math.Trunc(value) {
+			return "", 0, 0
+		}
+
+		return unit, int64(value), 1
+	}
+
+	values := d.durationValues(abs, negative)
+
+	shown, count := d.shownUnits(&values)
+	if count == 0 {
+		return "", 0, 0
+	}
+
+	return units[shown[0]], values[shown[0]], count
+}
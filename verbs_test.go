@@ -0,0 +1,68 @@
+package durufmt
+
+import (
+	"testing"
+	"time"
+)
+
+// TestPredicate тестирует согласование глагола с продолжительностью.
+func TestPredicate(t *testing.T) {
+	day := 24 * time.Hour
+
+	testVerbs := []struct {
+		name     string
+		test     *Durafmt
+		expected string
+	}{
+		{"мужской род", Parse(time.Hour).Predicate("осталось"), "остался 1 час"},
+		{"женский род", Parse(time.Minute).Predicate("осталось"), "осталась 1 минута"},
+		{"2", Parse(2 * time.Minute).Predicate("осталось"), "осталось 2 минуты"},
+		{"5", Parse(5 * time.Minute).Predicate("осталось"), "осталось 5 минут"},
+		{"11", Parse(11 * time.Minute).Predicate("осталось"), "осталось 11 минут"},
+		{"21", Parse(21 * time.Minute).Predicate("осталось"), "осталась 21 минута"},
+		{"ноль", Parse(0).Predicate("осталось"), "осталось 0 секунд"},
+		{"прошло, мужской род", Parse(time.Hour).Predicate("прошло"), "прошёл 1 час"},
+		{"прошло, женский род", Parse(7 * day).Predicate("прошло"), "прошла 1 неделя"},
+		{
+			"прошло, несколько единиц в единственном числе",
+			Parse(time.Hour + 5*time.Minute).Predicate("прошло"),
+			"прошёл 1 час 5 минут",
+		},
+		{"прошло, несколько единиц", Parse(2*time.Hour + time.Minute).Predicate("прошло"), "прошло 2 часа 1 минута"},
+		{"средний род", Parse(3650 * day).LimitToUnit(Decades).Predicate("прошло"), "прошло 1 десятилетие"},
+		{"прописью", Parse(time.Minute).Predicate("истекло").InWords(true), "истекла одна минута"},
+		{"сутки", Parse(day).Predicate("прошло").DayUnit(DayUnitSutki), "прошли 1 сутки"},
+		{"сутки прописью", Parse(day).Predicate("осталось").DayUnit(DayUnitSutki).InWords(true), "остались одни сутки"},
+		{
+			"сутки прописью, 2",
+			Parse(2 * day).Predicate("осталось").DayUnit(DayUnitSutki).InWords(true),
+			"осталось двое суток",
+		},
+		{"будущее время", Parse(time.Minute).Predicate("останется"), "останется 1 минута"},
+		{"винительный падеж", Parse(time.Minute).Predicate("займёт"), "займёт 1 минуту"},
+		{"винительный падеж без ё", Parse(time.Minute).Predicate("займет"), "займет 1 минуту"},
+		{"винительный падеж, 3", Parse(3 * time.Minute).Predicate("займёт"), "займёт 3 минуты"},
+		{"дробное число", Parse(90*time.Minute).Decimal(Hours, 1).Predicate("осталось"), "осталось 1,5 часа"},
+		{"дробное число, целое значение", Parse(time.Hour).Decimal(Hours, 1).Predicate("осталось"), "остался 1 час"},
+		{
+			"округление",
+			Parse(time.Hour + 59*time.Minute).LimitFirstN(1).Round(RoundHalfUp).Predicate("осталось"),
+			"осталось 2 часа",
+		},
+		{"неизвестный глагол", Parse(time.Minute).Predicate("ещё"), "ещё 1 минута"},
+		{"английский", Parse(time.Minute).Predicate("left").InLocale(English), "left 1 minute"},
+		{"пустой глагол", Parse(time.Minute).Predicate(""), "1 минута"},
+	}
+
+	for _, table := range testVerbs {
+		t.Run(table.name, func(t *testing.T) {
+			if result := table.test.String(); result != table.expected {
+				t.Errorf("String() = %q, ожидалось %q", result, table.expected)
+			}
+		})
+	}
+
+	if result := NewFormatter(WithPredicate("осталось")).Format(time.Minute); result != "осталась 1 минута" {
+		t.Errorf("Format() = %q, ожидалось %q", result, "осталась 1 минута")
+	}
+}
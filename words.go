@@ -0,0 +1,200 @@
+package durufmt
+
+import "strings"
+
+// Формы числительных хранятся в порядке падежей: именительный, родительный, дательный,
+// винительный, творительный, предложный.
+var caseIndex = map[string]int{
+	Nominative:    0,
+	Genitive:      1,
+	Dative:        2,
+	Accusative:    3,
+	Instrumental:  4,
+	Prepositional: 5,
+}
+
+var (
+	numeralZero = [6]string{"ноль", "нуля", "нулю", "ноль", "нулём", "нуле"}
+
+	// numeralOne и numeralTwo различаются по родам. С существительными, у которых есть только
+	// множественное число, вместо "два" в именительном и винительном падежах используется собирательное
+	// числительное ("двое суток"), а в остальных падежах — количественное ("двух суток", "двумя сутками").
+	numeralOne = map[string][6]string{
+		Masculine: {"один", "одного", "одному", "один", "одним", "одном"},
+		Feminine:  {"одна", "одной", "одной", "одну", "одной", "одной"},
+		Neuter:    {"одно", "одного", "одному", "одно", "одним", "одном"},
+		Plural:    {"одни", "одних", "одним", "одни", "одними", "одних"},
+	}
+	numeralTwo = map[string][6]string{
+		Masculine: {"два", "двух", "двум", "два", "двумя", "двух"},
+		Feminine:  {"две", "двух", "двум", "две", "двумя", "двух"},
+		Neuter:    {"два", "двух", "двум", "два", "двумя", "двух"},
+		Plural:    {"двое", "двух", "двум", "двое", "двумя", "двух"},
+	}
+
+	// numeralCollective содержит числительные 3 и 4 для существительных, у которых есть только
+	// множественное число: собирательные в именительном и винительном падежах ("трое суток"),
+	// количественные в остальных ("трёх суток", "четырьмя сутками").
+	numeralCollective = [5][6]string{
+		3: {"трое", "трёх", "трём", "трое", "тремя", "трёх"},
+		4: {"четверо", "четырёх", "четырём", "четверо", "четырьмя", "четырёх"},
+	}
+
+	// numeralUnits содержит числительные от 3 до 19, индекс совпадает с числом.
+	numeralUnits = [20][6]string{
+		3:  {"три", "трёх", "трём", "три", "тремя", "трёх"},
+		4:  {"четыре", "четырёх", "четырём", "четыре", "четырьмя", "четырёх"},
+		5:  {"пять", "пяти", "пяти", "пять", "пятью", "пяти"},
+		6:  {"шесть", "шести", "шести", "шесть", "шестью", "шести"},
+		7:  {"семь", "семи", "семи", "семь", "семью", "семи"},
+		8:  {"восемь", "восьми", "восьми", "восемь", "восемью", "восьми"},
+		9:  {"девять", "девяти", "девяти", "девять", "девятью", "девяти"},
+		10: {"десять", "десяти", "десяти", "десять", "десятью", "десяти"},
+		11: {"одиннадцать", "одиннадцати", "одиннадцати", "одиннадцать", "одиннадцатью", "одиннадцати"},
+		12: {"двенадцать", "двенадцати", "двенадцати", "двенадцать", "двенадцатью", "двенадцати"},
+		13: {"тринадцать", "тринадцати", "тринадцати", "тринадцать", "тринадцатью", "тринадцати"},
+		14: {"четырнадцать", "четырнадцати", "четырнадцати", "четырнадцать", "четырнадцатью", "четырнадцати"},
+		15: {"пятнадцать", "пятнадцати", "пятнадцати", "пятнадцать", "пятнадцатью", "пятнадцати"},
+		16: {"шестнадцать", "шестнадцати", "шестнадцати", "шестнадцать", "шестнадцатью", "шестнадцати"},
+		17: {"семнадцать", "семнадцати", "семнадцати", "семнадцать", "семнадцатью", "семнадцати"},
+		18: {"восемнадцать", "восемнадцати", "восемнадцати", "восемнадцать", "восемнадцатью", "восемнадцати"},
+		19: {"девятнадцать", "девятнадцати", "девятнадцати", "девятнадцать", "девятнадцатью", "девятнадцати"},
+	}
+
+	// numeralTens содержит десятки, индекс — количество десятков.
+	numeralTens = [10][6]string{
+		2: {"двадцать", "двадцати", "двадцати", "двадцать", "двадцатью", "двадцати"},
+		3: {"тридцать", "тридцати", "тридцати", "тридцать", "тридцатью", "тридцати"},
+		4: {"сорок", "сорока", "сорока", "сорок", "сорока", "сорока"},
+		5: {"пятьдесят", "пятидесяти", "пятидесяти", "пятьдесят", "пятьюдесятью", "пятидесяти"},
+		6: {"шестьдесят", "шестидесяти", "шестидесяти", "шестьдесят", "шестьюдесятью", "шестидесяти"},
+		7: {"семьдесят", "семидесяти", "семидесяти", "семьдесят", "семьюдесятью", "семидесяти"},
+		8: {"восемьдесят", "восьмидесяти", "восьмидесяти", "восемьдесят", "восемьюдесятью", "восьмидесяти"},
+		9: {"девяносто", "девяноста", "девяноста", "девяносто", "девяноста", "девяноста"},
+	}
+
+	// numeralHundreds содержит сотни, индекс — количество сотен.
+	numeralHundreds = [10][6]string{
+		1: {"сто", "ста", "ста", "сто", "ста", "ста"},
+		2: {"двести", "двухсот", "двумстам", "двести", "двумястами", "двухстах"},
+		3: {"триста", "трёхсот", "трёмстам", "триста", "тремястами", "трёхстах"},
+		4: {"четыреста", "четырёхсот", "четырёмстам", "четыреста", "четырьмястами", "четырёхстах"},
+		5: {"пятьсот", "пятисот", "пятистам", "пятьсот", "пятьюстами", "пятистах"},
+		6: {"шестьсот", "шестисот", "шестистам", "шестьсот", "шестьюстами", "шестистах"},
+		7: {"семьсот", "семисот", "семистам", "семьсот", "семьюстами", "семистах"},
+		8: {"восемьсот", "восьмисот", "восьмистам", "восемьсот", "восемьюстами", "восьмистах"},
+		9: {"девятьсот", "девятисот", "девятистам", "девятьсот", "девятьюстами", "девятистах"},
+	}
+
+	// numeralScales содержит названия разрядов от тысяч до квинтиллионов, которых достаточно
+	// для любого значения int64.
+	numeralScales = []struct {
+		gender string
+		forms  map[string][6]string
+	}{
+		{Feminine, map[string][6]string{
+			Singular: {"тысяча", "тысячи", "тысяче", "тысячу", "тысячей", "тысяче"},
+			Some:     {"тысячи", "тысяч", "тысячам", "тысячи", "тысячами", "тысячах"},
+			Many:     {"тысяч", "тысяч", "тысячам", "тысяч", "тысячами", "тысячах"},
+		}},
+		{Masculine, scaleForms("миллион")},
+		{Masculine, scaleForms("миллиард")},
+		{Masculine, scaleForms("триллион")},
+		{Masculine, scaleForms("квадриллион")},
+		{Masculine, scaleForms("квинтиллион")},
+	}
+)
+
+// scaleForms склоняет название разряда мужского рода с нулевым окончанием: "миллион", "миллиард".
+func scaleForms(base string) map[string][6]string {
+	return map[string][6]string{
+		Singular: {base, base + "а", base + "у", base, base + "ом", base + "е"},
+		Some:     {base + "а", base + "ов", base + "ам", base + "а", base + "ами", base + "ах"},
+		Many:     {base + "ов", base + "ов", base + "ам", base + "ов", base + "ами", base + "ах"},
+	}
+}
+
+// spellNumber записывает число n прописью в падеже grammaticalCase, согласуя его с родом gender
+// существительного, к которому относится число.
+func spellNumber(n int64, gender, grammaticalCase string) string {
+	c := caseIndex[grammaticalCase]
+
+	if _, ok := numeralOne[gender]; !ok {
+		gender = Masculine
+	}
+
+	if n == 0 {
+		return numeralZero[c]
+	}
+
+	words := make([]string, 0, 16)
+
+	// Модуль считается в uint64, чтобы не переполнить int64 для math.MinInt64.
+	abs := uint64(n)
+	if n < 0 {
+		words = append(words, "минус")
+		abs = uint64(-n)
+	}
+
+	// Собирательные числительные используются, только если всё число от 2 до 4: "двое суток",
+	// но "двадцать два суток" и "сто три суток".
+	if gender == Plural && abs > 4 {
+		if last := abs % 10; last >= 2 && last <= 4 {
+			gender = Masculine
+		}
+	}
+
+	// Разбиваем число на тройки цифр, начиная с младшей.
+	triads := make([]int64, 0, len(numeralScales)+1)
+	for abs > 0 {
+		triads = append(triads, int64(abs%1000))
+		abs /= 1000
+	}
+
+	for i := len(triads) - 1; i >= 0; i-- {
+		triad := triads[i]
+		if triad == 0 {
+			continue
+		}
+
+		if i == 0 {
+			words = append(words, spellTriad(triad, gender, c)...)
+
+			continue
+		}
+
+		scale := numeralScales[i-1]
+		words = append(words, spellTriad(triad, scale.gender, c)...)
+		words = append(words, scale.forms[pluralCategory(triad)][c])
+	}
+
+	return strings.Join(words, " ")
+}
+
+// spellTriad записывает прописью число от 1 до 999 в падеже с индексом c.
+func spellTriad(n int64, gender string, c int) []string {
+	words := make([]string, 0, 3)
+
+	if hundreds := n / 100; hundreds > 0 {
+		words = append(words, numeralHundreds[hundreds][c])
+	}
+
+	rest := n % 100
+	if rest >= 20 {
+		words = append(words, numeralTens[rest/10][c])
+		rest %= 10
+	}
+
+	switch {
+	case rest == 1:
+		words = append(words, numeralOne[gender][c])
+	case rest == 2:
+		words = append(words, numeralTwo[gender][c])
+	case rest >= 3 && rest <= 4 && gender == Plural:
+		words = append(words, numeralCollective[rest][c])
+	case rest > 2:
+		words = append(words, numeralUnits[rest][c])
+	}
+
+	return words
+}
@@ -0,0 +1,115 @@
+package durufmt
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestSpellNumber тестирует запись чисел прописью.
+func TestSpellNumber(t *testing.T) {
+	testNumbers := []struct {
+		test     int64
+		gender   string
+		gramCase string
+		expected string
+	}{
+		{0, Masculine, Nominative, "ноль"},
+		{1, Masculine, Nominative, "один"},
+		{1, Feminine, Nominative, "одна"},
+		{1, Neuter, Nominative, "одно"},
+		{2, Feminine, Nominative, "две"},
+		{2, Masculine, Nominative, "два"},
+		{11, Feminine, Nominative, "одиннадцать"},
+		{21, Feminine, Nominative, "двадцать одна"},
+		{40, Masculine, Nominative, "сорок"},
+		{99, Masculine, Nominative, "девяносто девять"},
+		{100, Masculine, Nominative, "сто"},
+		{342, Feminine, Nominative, "триста сорок две"},
+		{1000, Masculine, Nominative, "одна тысяча"},
+		{2001, Feminine, Nominative, "две тысячи одна"},
+		{5000, Masculine, Nominative, "пять тысяч"},
+		{21000, Masculine, Nominative, "двадцать одна тысяча"},
+		{1000000, Masculine, Nominative, "один миллион"},
+		{2000000, Masculine, Nominative, "два миллиона"},
+		{1001001, Feminine, Nominative, "один миллион одна тысяча одна"},
+		{-5, Masculine, Nominative, "минус пять"},
+		{
+			math.MaxInt64, Masculine, Nominative,
+			"девять квинтиллионов двести двадцать три квадриллиона триста семьдесят два триллиона " +
+				"тридцать шесть миллиардов восемьсот пятьдесят четыре миллиона семьсот семьдесят пять тысяч " +
+				"восемьсот семь",
+		},
+		{1, Feminine, Accusative, "одну"},
+		{1000, Masculine, Accusative, "одну тысячу"},
+		{21, Feminine, Genitive, "двадцати одной"},
+		{2, Masculine, Genitive, "двух"},
+		{3, Feminine, Instrumental, "тремя"},
+		{48, Masculine, Instrumental, "сорока восемью"},
+		{200, Masculine, Dative, "двумстам"},
+		{2000, Masculine, Instrumental, "двумя тысячами"},
+		{555, Masculine, Prepositional, "пятистах пятидесяти пяти"},
+		{0, Masculine, Instrumental, "нулём"},
+	}
+
+	for _, table := range testNumbers {
+		result := spellNumber(table.test, table.gender, table.gramCase)
+		if result != table.expected {
+			t.Errorf("spellNumber(%d, %q, %q) = %q, ожидалось %q",
+				table.test, table.gender, table.gramCase, result, table.expected)
+		}
+	}
+}
+
+// TestParseInWords тестирует форматирование time.Duration с количествами прописью.
+func TestParseInWords(t *testing.T) {
+	testTimesInWords := []struct {
+		test     time.Duration
+		gramCase string
+		expected string
+	}{
+		{1 * time.Minute, "", "одна минута"},
+		{2 * 168 * time.Hour, "", "две недели"},
+		{21 * 8760 * time.Hour, "", "двадцать один год"},
+		{1 * time.Hour, "", "один час"},
+		{(354 * time.Hour) + (22 * time.Minute) + (3 * time.Second), "",
+			"две недели восемнадцать часов двадцать две минуты три секунды"},
+		{-2 * time.Second, "", "минус две секунды"},
+		{1 * time.Minute, Accusative, "одну минуту"},
+		{21 * time.Second, Genitive, "двадцати одной секунды"},
+		{2 * time.Hour, Instrumental, "двумя часами"},
+		{2000 * time.Millisecond, "", "две секунды"},
+		{1000 * time.Hour, Instrumental, "пятью неделями шестью днями шестнадцатью часами"},
+	}
+
+	for _, table := range testTimesInWords {
+		result := Parse(table.test).InCase(table.gramCase).InWords(true).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).InCase(%q).InWords(true).String() = %q. получено %q, ожидалось %q",
+				table.test, table.gramCase, result, result, table.expected)
+		}
+	}
+
+	result := Parse(21 * time.Minute).LimitToUnit(Seconds).InWords(true).InCase(Instrumental).String()
+	if result != "одной тысячей двумястами шестьюдесятью секундами" {
+		t.Errorf("получено %q", result)
+	}
+
+	result = Parse(1000 * time.Second).LimitToUnit(Seconds).InWords(true).InCase(Instrumental).String()
+	if result != "одной тысячей секунд" {
+		t.Errorf("получено %q", result)
+	}
+
+	d, err := ParseString("0s")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result := d.InWords(true).String(); result != "ноль секунд" {
+		t.Errorf("ParseString(\"0s\").InWords(true).String() = %q, ожидалось %q", result, "ноль секунд")
+	}
+
+	if result := ParseShort(21 * 8760 * time.Hour).InWords(true).String(); result != "двадцать один год" {
+		t.Errorf("ParseShort(...).InWords(true).String() = %q, ожидалось %q", result, "двадцать один год")
+	}
+}
@@ -0,0 +1,272 @@
+package durufmt
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"time"
+)
+
+// workAdjective хранит формы прилагательного "рабочий" по родам (Masculine, Feminine, Neuter, Plural)
+// в порядке caseIndex.
+var workAdjective = map[string][6]string{
+	Masculine: {"рабочий", "рабочего", "рабочему", "рабочий", "рабочим", "рабочем"},
+	Feminine:  {"рабочая", "рабочей", "рабочей", "рабочую", "рабочей", "рабочей"},
+	Neuter:    {"рабочее", "рабочего", "рабочему", "рабочее", "рабочим", "рабочем"},
+	Plural:    {"рабочие", "рабочих", "рабочим", "рабочие", "рабочими", "рабочих"},
+}
+
+// WorkDay включает вывод рабочего времени: день равен рабочему дню длиной length, а перед названиями
+// единиц времени стоит прилагательное "рабочий", согласованное с числом: "3 рабочих дня 4 рабочих часа",
+// "1 рабочая минута". Годы, месяцы и недели не выводятся. Прилагательное выводится только на русском
+// языке и только с полными названиями единиц времени. length = 0 означает обычное время.
+func (d *Durafmt) WorkDay(length time.Duration) *Durafmt {
+	return d.with(WithWorkDay(length))
+}
+
+// unitSize возвращает длину единицы времени unit с учётом рабочего дня.
+func (d *Durafmt) unitSize(unit string) time.Duration {
+	return unitSize(unit, d.workDay)
+}
+
+// unitSize возвращает длину единицы времени unit. workDay > 0 означает, что день равен рабочему дню.
+func unitSize(unit string, workDay time.Duration) time.Duration {
+	if unit == Days && workDay > 0 {
+		return workDay
+	}
+
+	return unitDurations[unitIndex(unit)]
+}
+
+// workUnitIndex возвращает индекс единицы времени idx, а для рабочего времени, в котором нет единиц старше
+// рабочего дня, — не больше индекса дней. Отрицательный idx возвращается без изменений.
+func (d *Durafmt) workUnitIndex(idx int) int {
+	if d.workDay > 0 && idx >= 0 && idx < unitIndex(Days) {
+		return unitIndex(Days)
+	}
+
+	return idx
+}
+
+// fixedValues раскладывает продолжительность по единицам времени фиксированной длины, а рабочее время —
+// по рабочим дням и меньшим единицам.
+func (d *Durafmt) fixedValues(duration time.Duration) durationValues {
+	var values durationValues
+
+	if d.workDay <= 0 {
+		values = fixedDurationValues(duration, d.limitUnit)
+	} else {
+		start := d.workUnitIndex(unitIndex(d.limitUnit))
+		if start < 0 {
+			start = unitIndex(Days)
+		}
+
+		for idx, rest := start, duration; idx < len(units); idx++ {
+			size := d.unitSize(units[idx])
+			values[idx] = int64(rest / size)
+			rest -= time.Duration(values[idx]) * size
+		}
+	}
+
+	// Модуль math.MinInt64 на наносекунду больше math.MaxInt64, который возвращает abs(). Если все
+	// наносекунды попали в одну единицу времени, эта наносекунда не помещается в int64 и теряется.
+	if d.duration == math.MinInt64 && duration == math.MaxInt64 && values[len(units)-1] < math.MaxInt64 {
+		values[len(units)-1]++
+	}
+
+	return values
+}
+
+// appendUnitName дописывает в b название единицы времени unit, а для рабочего времени — и согласованное
+// с ним прилагательное "рабочий".
+func (d *Durafmt) appendUnitName(b []byte, grammaticalCase, unit, category string) []byte {
+	if d.workDay > 0 && d.russian() {
+		b = append(b, workAdjectiveForm(d.unitGender(unit), grammaticalCase, category)...)
+		b = append(b, ' ')
+	}
+
+	return append(b, d.unitName(grammaticalCase, unit, category)...)
+}
+
+// workAdjectiveForm возвращает форму прилагательного "рабочий" перед существительным рода gender в падеже
+// grammaticalCase после числа категории category. После 1, 21... прилагательное стоит в единственном числе
+// ("1 рабочий день"), после дробного числа — в родительном падеже единственного числа ("1,5 рабочего дня"),
+// после остальных чисел в именительном и винительном падежах — в родительном падеже множественного числа
+// ("3 рабочих дня"), кроме женского рода после 2, 3 и 4 ("2 рабочие минуты"), в остальных падежах —
+// во множественном числе того же падежа ("3 рабочим дням").
+func workAdjectiveForm(gender, grammaticalCase, category string) string {
+	c := caseIndex[grammaticalCase]
+
+	switch {
+	case category == Singular:
+		return workAdjective[gender][c]
+	case category == Other:
+		return workAdjective[gender][caseIndex[Genitive]]
+	case c != caseIndex[Nominative] && c != caseIndex[Accusative]:
+		return workAdjective[Plural][c]
+	case category == Some && gender == Feminine:
+		return workAdjective[Plural][caseIndex[Nominative]]
+	default:
+		return workAdjective[Plural][caseIndex[Genitive]]
+	}
+}
+
+// date — календарная дата без времени и часового пояса.
+type date struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+// dateOf возвращает календарную дату момента t в его часовом поясе.
+func dateOf(t time.Time) date {
+	year, month, day := t.Date()
+
+	return date{year, month, day}
+}
+
+// WorkCalendar — производственный календарь: рабочие часы, выходные (суббота и воскресенье), праздники
+// и перенесённые рабочие дни. Праздники и переносы задаются через AddHoliday() и AddWorkday() или
+// загружаются из файла через LoadFile().
+type WorkCalendar struct {
+	DayStart time.Duration // Начало рабочего дня от полуночи, например 9 * time.Hour.
+	DayEnd   time.Duration // Конец рабочего дня от полуночи, например 18 * time.Hour.
+
+	holidays map[date]bool
+	workdays map[date]bool
+}
+
+// NewWorkCalendar создаёт *WorkCalendar с рабочим днём от dayStart до dayEnd (от полуночи)
+// и выходными в субботу и воскресенье.
+func NewWorkCalendar(dayStart, dayEnd time.Duration) *WorkCalendar {
+	return &WorkCalendar{DayStart: dayStart, DayEnd: dayEnd}
+}
+
+// AddHoliday делает дату day нерабочим днём, например государственным праздником.
+func (c *WorkCalendar) AddHoliday(day time.Time) {
+	if c.holidays == nil {
+		c.holidays = make(map[date]bool)
+	}
+
+	key := dateOf(day)
+	c.holidays[key] = true
+	delete(c.workdays, key)
+}
+
+// AddWorkday делает дату day рабочим днём, например субботу, на которую перенесён выходной.
+func (c *WorkCalendar) AddWorkday(day time.Time) {
+	if c.workdays == nil {
+		c.workdays = make(map[date]bool)
+	}
+
+	key := dateOf(day)
+	c.workdays[key] = true
+	delete(c.holidays, key)
+}
+
+// Load загружает праздники и перенесённые рабочие дни из r. Каждая строка содержит дату в формате
+// ГГГГ-ММ-ДД и её тип: "holiday" (нерабочий день) или "workday" (рабочий день), например
+// "2024-04-29 holiday" или "2024-04-27 workday". Пустые строки и строки, начинающиеся с "#", пропускаются.
+// В случае неправильных входных данных возвращает ошибку с номером строки.
+func (c *WorkCalendar) Load(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return fmt.Errorf("durafmt_ru: строка %d: ожидались дата и тип дня, получено %q", line, text)
+		}
+
+		day, err := time.Parse("2006-01-02", fields[0])
+		if err != nil {
+			return fmt.Errorf("durafmt_ru: строка %d: неправильная дата %q", line, fields[0])
+		}
+
+		switch fields[1] {
+		case "holiday":
+			c.AddHoliday(day)
+		case "workday":
+			c.AddWorkday(day)
+		default:
+			return fmt.Errorf("durafmt_ru: строка %d: неизвестный тип дня %q", line, fields[1])
+		}
+	}
+
+	return scanner.Err()
+}
+
+// LoadFile загружает праздники и перенесённые рабочие дни из файла path, см. Load().
+func (c *WorkCalendar) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return c.Load(f)
+}
+
+// IsWorkday сообщает, является ли дата момента t (в его часовом поясе) рабочим днём.
+func (c *WorkCalendar) IsWorkday(t time.Time) bool {
+	key := dateOf(t)
+
+	switch {
+	case c.workdays[key]:
+		return true
+	case c.holidays[key]:
+		return false
+	default:
+		weekday := t.Weekday()
+
+		return weekday != time.Saturday && weekday != time.Sunday
+	}
+}
+
+// WorkingTime возвращает рабочее время между from и to. Дни считаются в часовом поясе from.
+// Если to раньше from, рабочее время будет отрицательным.
+func (c *WorkCalendar) WorkingTime(from, to time.Time) time.Duration {
+	if to.Before(from) {
+		return -c.WorkingTime(to.In(from.Location()), from)
+	}
+
+	to = to.In(from.Location())
+
+	var total time.Duration
+
+	year, month, day := from.Date()
+	for midnight := time.Date(year, month, day, 0, 0, 0, 0, from.Location()); midnight.Before(to); {
+		if c.IsWorkday(midnight) {
+			start, end := midnight.Add(c.DayStart), midnight.Add(c.DayEnd)
+			if start.Before(from) {
+				start = from
+			}
+
+			if end.After(to) {
+				end = to
+			}
+
+			if end.After(start) {
+				total += end.Sub(start)
+			}
+		}
+
+		year, month, day = midnight.Date()
+		midnight = time.Date(year, month, day+1, 0, 0, 0, 0, from.Location())
+	}
+
+	return total
+}
+
+// Between создаёт структуру *Durafmt для рабочего времени между from и to:
+// "3 рабочих дня 4 рабочих часа", см. WorkingTime() и Durafmt.WorkDay().
+func (c *WorkCalendar) Between(from, to time.Time) *Durafmt {
+	return Parse(c.WorkingTime(from, to)).WorkDay(c.DayEnd - c.DayStart)
+}
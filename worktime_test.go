@@ -0,0 +1,137 @@
+package durufmt
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestWorkDay тестирует вывод рабочего времени и согласование прилагательного "рабочий".
+func TestWorkDay(t *testing.T) {
+	day := 8 * time.Hour
+
+	testWorkDays := []struct {
+		name     string
+		test     *Durafmt
+		expected string
+	}{
+		{"1 день", Parse(day).WorkDay(day), "1 рабочий день"},
+		{"2 дня", Parse(2 * day).WorkDay(day), "2 рабочих дня"},
+		{"5 дней", Parse(5 * day).WorkDay(day), "5 рабочих дней"},
+		{"21 день", Parse(21 * day).WorkDay(day), "21 рабочий день"},
+		{"дни и часы", Parse(3*day + 4*time.Hour).WorkDay(day), "3 рабочих дня 4 рабочих часа"},
+		{"1 минута", Parse(time.Minute).WorkDay(day), "1 рабочая минута"},
+		{"2 минуты", Parse(2 * time.Minute).WorkDay(day), "2 рабочие минуты"},
+		{"5 минут", Parse(5 * time.Minute).WorkDay(day), "5 рабочих минут"},
+		{"ноль", Parse(0).WorkDay(day), "0 рабочих секунд"},
+		{"родительный падеж", Parse(2 * day).WorkDay(day).InCase(Genitive), "2 рабочих дней"},
+		{"дательный падеж", Parse(2 * day).WorkDay(day).InCase(Dative), "2 рабочим дням"},
+		{"винительный падеж", Parse(time.Minute).WorkDay(day).InCase(Accusative), "1 рабочую минуту"},
+		{"творительный падеж", Parse(day).WorkDay(day).InCase(Instrumental), "1 рабочим днём"},
+		{"предложный падеж", Parse(2 * time.Hour).WorkDay(day).InCase(Prepositional), "2 рабочих часах"},
+		{"дробное число", Parse(12*time.Hour).WorkDay(day).Decimal(Days, 1), "1,5 рабочего дня"},
+		{"дробное число, единица по умолчанию", Parse(12*time.Hour).WorkDay(day).Decimal("", 1), "1,5 рабочего дня"},
+		{"дробное число, минуты", Parse(90*time.Second).WorkDay(day).Decimal(Minutes, 1), "1,5 рабочей минуты"},
+		{"прописью", Parse(2 * day).WorkDay(day).InWords(true), "два рабочих дня"},
+		{"LimitToUnit", Parse(12 * time.Hour).WorkDay(day).LimitToUnit(Hours), "12 рабочих часов"},
+		{"округление", Parse(12 * time.Hour).WorkDay(day).LimitFirstN(1).Round(RoundHalfUp), "2 рабочих дня"},
+		{"LimitToSmallestUnit", Parse(12 * time.Hour).WorkDay(day).LimitToSmallestUnit(Weeks), "1 рабочий день"},
+		{"недели не выводятся", Parse(10 * day).WorkDay(day), "10 рабочих дней"},
+		{"отрицательная продолжительность", Parse(-12 * time.Hour).WorkDay(day), "-1 рабочий день 4 рабочих часа"},
+		{"StyleShort", Parse(12 * time.Hour).WorkDay(day).InStyle(StyleShort), "1 д 4 ч"},
+		{"английский", Parse(12 * time.Hour).WorkDay(day).InLocale(English), "1 day 4 hours"},
+		{"Period", Parse(day).WorkDay(day).Period("за", "последний"), "за последний рабочий день"},
+		{"Period, 2", Parse(2*day).WorkDay(day).Period("за", "последний"), "за последние 2 рабочих дня"},
+		{"Predicate", Parse(day).WorkDay(day).Predicate("осталось"), "остался 1 рабочий день"},
+		{"нулевой рабочий день", Parse(12 * time.Hour).WorkDay(0), "12 часов"},
+	}
+
+	for _, table := range testWorkDays {
+		t.Run(table.name, func(t *testing.T) {
+			if result := table.test.String(); result != table.expected {
+				t.Errorf("String() = %q, ожидалось %q", result, table.expected)
+			}
+		})
+	}
+
+	f := NewFormatter(WithWorkDay(day))
+	if result := f.Format(3*day + 4*time.Hour); result != "3 рабочих дня 4 рабочих часа" {
+		t.Errorf("Format() = %q, ожидалось %q", result, "3 рабочих дня 4 рабочих часа")
+	}
+
+	if result := f.FormatRange(2*day, 3*day); result != "2–3 рабочих дня" {
+		t.Errorf("FormatRange() = %q, ожидалось %q", result, "2–3 рабочих дня")
+	}
+}
+
+// TestWorkCalendar тестирует подсчёт рабочего времени по производственному календарю.
+func TestWorkCalendar(t *testing.T) {
+	msk := time.FixedZone("MSK", 3*60*60)
+	at := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2024, month, day, hour, 0, 0, 0, msk)
+	}
+
+	cal := NewWorkCalendar(9*time.Hour, 18*time.Hour)
+	if err := cal.LoadFile("testdata/calendar_2024.txt"); err != nil {
+		t.Fatalf("LoadFile() вернула ошибку: %v", err)
+	}
+
+	plain := NewWorkCalendar(9*time.Hour, 18*time.Hour)
+
+	testCalendars := []struct {
+		calendar *WorkCalendar
+		from, to time.Time
+		expected string
+	}{
+		// Суббота 27 апреля рабочая, с 29 апреля по 1 мая — праздники.
+		{cal, at(time.April, 26, 9), at(time.May, 3, 13), "3 рабочих дня 4 рабочих часа"},
+		{plain, at(time.April, 26, 9), at(time.May, 3, 13), "5 рабочих дней 4 рабочих часа"},
+		{cal, at(time.May, 3, 13), at(time.April, 26, 9), "-3 рабочих дня 4 рабочих часа"},
+		{cal, at(time.April, 26, 7), at(time.April, 26, 20), "1 рабочий день"},
+		{cal, at(time.April, 26, 17), at(time.April, 27, 10), "2 рабочих часа"},
+		{cal, at(time.April, 28, 9), at(time.April, 28, 18), "0 рабочих секунд"},
+		{cal, at(time.December, 27, 18), at(time.December, 28, 9), "0 рабочих секунд"},
+		{cal, at(time.May, 8, 12), at(time.May, 13, 12), "1 рабочий день"},
+	}
+
+	for _, table := range testCalendars {
+		if result := table.calendar.Between(table.from, table.to).String(); result != table.expected {
+			t.Errorf("Between(%v, %v) = %q, ожидалось %q", table.from, table.to, result, table.expected)
+		}
+	}
+
+	if !cal.IsWorkday(at(time.November, 2, 12)) || cal.IsWorkday(at(time.November, 4, 12)) ||
+		!cal.IsWorkday(at(time.November, 5, 12)) {
+		t.Error("IsWorkday() неправильно учитывает перенос выходных")
+	}
+
+	var zero WorkCalendar
+	zero.AddHoliday(at(time.May, 13, 0))
+
+	if zero.IsWorkday(at(time.May, 13, 12)) {
+		t.Error("AddHoliday() не сделал день выходным у нулевого WorkCalendar")
+	}
+}
+
+// TestWorkCalendarLoadErrors тестирует ошибки загрузки производственного календаря.
+func TestWorkCalendarLoadErrors(t *testing.T) {
+	testErrors := []struct {
+		input    string
+		expected string
+	}{
+		{"2024-01-01", "durafmt_ru: строка 1: ожидались дата и тип дня"},
+		{"# комментарий\n\n2024-13-01 holiday", "durafmt_ru: строка 3: неправильная дата \"2024-13-01\""},
+		{"2024-01-01 vacation", "durafmt_ru: строка 1: неизвестный тип дня \"vacation\""},
+	}
+
+	for _, table := range testErrors {
+		err := NewWorkCalendar(9*time.Hour, 18*time.Hour).Load(strings.NewReader(table.input))
+		if err == nil || !strings.HasPrefix(err.Error(), table.expected) {
+			t.Errorf("Load(%q) = %v, ожидалась ошибка %q", table.input, err, table.expected)
+		}
+	}
+
+	if err := NewWorkCalendar(9*time.Hour, 18*time.Hour).LoadFile("testdata/missing.txt"); err == nil {
+		t.Error("LoadFile() не вернула ошибку для несуществующего файла")
+	}
+}